@@ -1,21 +1,11 @@
-resource "squadcast_deduplication_rules" "test" {
-  team_id    = "owner_id"
+resource "squadcast_deduplication_rule" "same_host" {
+  team_id    = "team_id"
   service_id = "service_id"
+  priority   = 0
 
-  rules {
-    is_basic    = false
-    description = "not basic"
-    expression  = "payload[\"event_id\"] == 40"
-  }
-
-  rules {
-    is_basic    = true
-    description = "basic"
-
-    basic_expressions {
-      lhs = "payload[\"foo\"]"
-      op  = "is"
-      rhs = "bar"
-    }
-  }
-}
\ No newline at end of file
+  is_basic    = false
+  description = "Alerts from the same host"
+  expression  = "past.payload.host == current.payload.host"
+  time_window = 1
+  time_unit   = "hour"
+}
@@ -62,6 +62,22 @@ resource "squadcast_escalation_policy" "test" {
     }
   }
 
+  rules {
+    delay_minutes = 15
+
+    targets {
+      user_email = "john@example.com"
+    }
+
+    targets {
+      squad_name = "Database Squad"
+    }
+
+    targets {
+      schedule_name = "Primary On-call"
+    }
+  }
+
   repeat {
     times         = 2
     delay_minutes = 10
@@ -0,0 +1,7 @@
+resource "squadcast_global_event_rule" "test" {
+  ruleset_id  = "ruleset_id"
+  description = "Prometheus alerts go to the infra service"
+  expression  = "payload[\"source\"] == \"prometheus\""
+  route_to    = "service_id"
+  position    = 0
+}
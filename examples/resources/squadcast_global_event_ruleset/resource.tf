@@ -0,0 +1,12 @@
+resource "squadcast_global_event_ruleset" "test" {
+  name        = "Central ingestion"
+  description = "Routes alerts from the shared monitoring stack"
+  team_id     = "team_id"
+
+  entity_owner {
+    type = "squad"
+    id   = "squad_id"
+  }
+
+  catch_all_route_to = "service_id"
+}
@@ -1,24 +1,15 @@
-resource "squadcast_routing_rules" "test" {
+resource "squadcast_routing_rule" "database" {
   team_id    = "team_id"
   service_id = "service_id"
+  priority   = 0
 
-  rules {
-    is_basic   = false
-    expression = "payload[\"event_id\"] == 40"
+  is_basic = true
 
-    route_to_id   = "user_id/squad_id/escalatio_policy_id"
-    route_to_type = "user/squad/escalation_policy"
+  basic_expressions {
+    lhs = "payload[\"team\"]"
+    rhs = "database"
   }
 
-  rules {
-    is_basic = true
-
-    basic_expressions {
-      lhs = "payload[\"foo\"]"
-      rhs = "bar"
-    }
-
-    route_to_id   = "user_id/squad_id/escalatio_policy_id"
-    route_to_type = "user/squad/escalation_policy"
-  }
-}
\ No newline at end of file
+  route_to_id   = "squad_id"
+  route_to_type = "squad"
+}
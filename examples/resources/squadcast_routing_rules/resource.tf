@@ -0,0 +1,31 @@
+resource "squadcast_routing_rules" "test" {
+  team_id    = "team_id"
+  service_id = "service_id"
+
+  rules {
+    is_basic   = false
+    expression = "payload[\"event_id\"] == 40"
+
+    route_to_id   = "user_id/squad_id/escalationpolicy_id/schedule_id"
+    route_to_type = "user/squad/escalationpolicy/schedule"
+  }
+
+  rules {
+    is_basic = true
+
+    basic_expressions {
+      lhs = "payload[\"foo\"]"
+      rhs = "bar"
+    }
+
+    route_to_type = "squad"
+    route_to_name = "Database squad"
+  }
+
+  rules {
+    is_basic   = false
+    expression = "payload[\"owner\"] == \"jdoe\""
+
+    route_to_email = "john@example.com"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "squadcast_service_alert_sources" "test" {
+  team_id       = "team_id"
+  service_id    = "service_id"
+  alert_sources = ["prometheus", "grafana"]
+}
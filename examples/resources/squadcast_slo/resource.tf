@@ -6,7 +6,6 @@ resource "squadcast_slo" "test" {
   slis               = ["latency", "high-err-rate"]
   time_interval_type = "rolling"
   duration_in_days   = 7
-  org_id             = "604592dabc35ea0008bb0584"
 
   rules {
     name = "breached_error_budget"
@@ -23,9 +22,10 @@ resource "squadcast_slo" "test" {
   }
 
   notify {
-    users = ["5e1c2309342445001180f9c2", "617793e650d38001057faaaf"]
+    user_ids    = ["5e1c2309342445001180f9c2", "617793e650d38001057faaaf"]
+    squad_ids   = ["61305a78127c63c6d2c8f746"]
+    service_ids = ["615d3e23aff6885f46d291be"]
   }
 
-  owner_type = "team"
-  team_id    = "611262fcd5b4ea846b534a8a"
-}
\ No newline at end of file
+  team_id = "611262fcd5b4ea846b534a8a"
+}
@@ -0,0 +1,15 @@
+resource "squadcast_status_page" "test" {
+  name                     = "Acme Status"
+  description              = "Current status of Acme services"
+  team_id                  = "owner_id"
+  domain_name              = "acme"
+  custom_domain_name       = "status.acme.com"
+  timezone                 = "America/New_York"
+  contact_email            = "support@acme.com"
+  hide_from_search_engines = false
+
+  theme_color {
+    primary   = "#0f61dd"
+    secondary = "#ffffff"
+  }
+}
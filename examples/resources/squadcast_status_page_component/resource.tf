@@ -0,0 +1,7 @@
+resource "squadcast_status_page_component" "test" {
+  status_page_id = "status_page_id"
+  group_id       = "component_group_id"
+  service_id     = "service_id"
+  name           = "API"
+  description    = "Public REST API"
+}
@@ -0,0 +1,5 @@
+resource "squadcast_status_page_component_group" "test" {
+  status_page_id = "status_page_id"
+  name           = "Backend"
+  description    = "Backend services"
+}
@@ -1,10 +1,9 @@
-resource "squadcast_suppression_rules" "test" {
-  team_id    = "owner_id"
+resource "squadcast_suppression_rule" "staging" {
+  team_id    = "team_id"
   service_id = "service_id"
+  priority   = 0
 
-  rules {
-    is_basic    = false
-    description = "not basic"
-    expression  = "payload[\"event_id\"] == 40"
-  }
-}
\ No newline at end of file
+  is_basic    = false
+  description = "Alerts from staging"
+  expression  = "payload[\"env\"] == \"staging\""
+}
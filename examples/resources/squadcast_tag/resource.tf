@@ -0,0 +1,5 @@
+resource "squadcast_tag" "severity" {
+  key    = "severity"
+  values = ["critical", "high", "low"]
+  colors = ["#ff0000", "#ffa500"]
+}
@@ -1,37 +1,14 @@
-resource "squadcast_tagging_rules" "test" {
-  team_id    = "owner_id"
+resource "squadcast_tagging_rule" "database" {
+  team_id    = "team_id"
   service_id = "service_id"
+  priority   = 0
 
-  rules {
-    is_basic   = false
-    expression = "payload[\"event_id\"] == 40"
+  is_basic   = false
+  expression = "startsWith(payload[\"host\"], \"db-\")"
 
-    tags {
-      key   = "MyTag"
-      value = "foo"
-      color = "#ababab"
-    }
+  tags {
+    key   = "tier"
+    value = "database"
+    color = "#ababab"
   }
-
-  rules {
-    is_basic = true
-
-    basic_expressions {
-      lhs = "payload[\"foo\"]"
-      op  = "is"
-      rhs = "bar"
-    }
-
-    tags {
-      key   = "MyTag"
-      value = "foo"
-      color = "#ababab"
-    }
-
-    tags {
-      key   = "MyTag2"
-      value = "bar"
-      color = "#f0f0f0"
-    }
-  }
-}
\ No newline at end of file
+}
@@ -0,0 +1,43 @@
+resource "squadcast_tagging_rules" "test" {
+  team_id    = "owner_id"
+  service_id = "service_id"
+
+  rules {
+    is_basic   = false
+    expression = "payload[\"event_id\"] == 40"
+
+    tags {
+      key   = "MyTag"
+      value = "foo"
+      color = "#ababab"
+    }
+  }
+
+  rules {
+    is_basic = true
+
+    basic_expressions {
+      lhs = "payload[\"foo\"]"
+      op  = "is"
+      rhs = "bar"
+    }
+
+    tags {
+      key   = "MyTag"
+      value = "foo"
+      color = "#ababab"
+    }
+
+    tags {
+      key   = "MyTag2"
+      value = "bar"
+      color = "#f0f0f0"
+    }
+
+    tags {
+      key   = "host"
+      value = "{{ payload.labels.instance | default \"unknown\" }}"
+      color = "#0f61dd"
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,27 @@
+data "squadcast_user" "example_user" {
+  email = "test@example.com"
+}
+
+resource "squadcast_user_notification_rules" "test" {
+  user_id = data.squadcast_user.example_user.id
+
+  notification_rules {
+    type          = "Push"
+    delay_minutes = 0
+  }
+
+  notification_rules {
+    type          = "SMS"
+    delay_minutes = 5
+  }
+
+  notification_rules {
+    type          = "Phone"
+    delay_minutes = 10
+  }
+
+  oncall_reminder_rules {
+    type          = "Email"
+    delay_minutes = 60
+  }
+}
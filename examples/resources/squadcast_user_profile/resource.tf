@@ -0,0 +1,13 @@
+resource "squadcast_user" "example_user" {
+  first_name = "example"
+  last_name  = "user"
+  email      = "test@example.com"
+  role       = "user"
+}
+
+resource "squadcast_user_profile" "test" {
+  user_id   = squadcast_user.example_user.id
+  phone     = "+14155552671"
+  time_zone = "America/Los_Angeles"
+  title     = "Site Reliability Engineer"
+}
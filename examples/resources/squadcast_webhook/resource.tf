@@ -0,0 +1,20 @@
+resource "squadcast_webhook" "test" {
+  name        = "ticketing"
+  description = "Open a ticket for every new incident"
+  team_id     = "owner_id"
+  url         = "https://tickets.example.com/hooks/squadcast"
+  triggers    = ["triggered", "resolved"]
+  service_ids = ["service_id"]
+
+  headers {
+    key   = "Authorization"
+    value = "Bearer some-secret"
+  }
+
+  tag_filters {
+    key   = "severity"
+    value = "critical"
+  }
+
+  payload_template = "{\"title\": \"{{ .message }}\"}"
+}
@@ -4,20 +4,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 type AlertSource struct {
-	ID             string `json:"_id"`
-	Type           string `json:"type"`
-	Heading        string `json:"heading"`
-	SupportDocURL  string `json:"supportDoc"`
-	DisplayKeyOnly bool   `json:"displayKeyOnly"`
-	ShortName      string `json:"shortName"`
-	Version        string `json:"version"`
-
-	IsValid      bool `json:"isValid"`
-	IsPrivate    bool `json:"isPrivate"`
-	IsDeprecated bool `json:"deprecated"`
+	ID             string `json:"_id" tf:"id"`
+	Type           string `json:"type" tf:"type"`
+	Heading        string `json:"heading" tf:"heading"`
+	SupportDocURL  string `json:"supportDoc" tf:"support_doc_url"`
+	DisplayKeyOnly bool   `json:"displayKeyOnly" tf:"display_key_only"`
+	ShortName      string `json:"shortName" tf:"short_name"`
+	Version        string `json:"version" tf:"version"`
+
+	IsValid      bool `json:"isValid" tf:"-"`
+	IsPrivate    bool `json:"isPrivate" tf:"is_private"`
+	IsDeprecated bool `json:"deprecated" tf:"is_deprecated"`
+}
+
+func (alertSource *AlertSource) Encode() (tf.M, error) {
+	return tf.Encode(alertSource)
 }
 
 type AlertSourcesList []*AlertSource
@@ -61,3 +67,18 @@ func (client *Client) ListAlertSources(ctx context.Context) (AlertSourcesList, e
 
 	return RequestSlice[any, AlertSource](http.MethodGet, url, client, ctx, nil)
 }
+
+func (client *Client) GetAlertSourceByShortName(ctx context.Context, shortName string) (*AlertSource, error) {
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, as := range alertSources {
+		if as.ShortName == shortName {
+			return as, nil
+		}
+	}
+
+	return nil, fmt.Errorf("could not find an alert source with short name `%s`", shortName)
+}
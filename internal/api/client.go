@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -17,12 +18,15 @@ type Client struct {
 	RefreshToken   string
 	AccessToken    string
 	OrganizationID string
+	// StrictTags is true when tagging rules may only add the tags of the tag settings of the organization.
+	StrictTags bool
 
 	UserAgent        string
 	BaseURLV2        string
 	BaseURLV3        string
 	AuthBaseURL      string
 	IngestionBaseURL string
+	AppBaseURL       string
 }
 
 type ErrorDetails struct {
@@ -108,7 +112,10 @@ func Request[TReq any, TRes any](method string, url string, client *Client, ctx
 
 	if resp.StatusCode > 299 {
 		if response.Meta != nil {
-			return nil, fmt.Errorf("%s %s returned an error:\n%s", method, url, response.Meta.Meta.Error())
+			if response.Meta.Meta.Status == 0 {
+				response.Meta.Meta.Status = resp.StatusCode
+			}
+			return nil, fmt.Errorf("%s %s returned an error:\n%w", method, url, &response.Meta.Meta)
 		} else {
 			return nil, fmt.Errorf("%s %s returned %d with an unexpected error: %#v", method, url, resp.StatusCode, response)
 		}
@@ -129,3 +136,18 @@ func RequestSlice[TReq any, TRes any](method string, url string, client *Client,
 func IsResourceNotFoundError(e error) bool {
 	return strings.Contains(e.Error(), "[404]")
 }
+
+// IsConflictError returns whether the API rejected the request because it conflicts with the current state of the resource.
+func IsConflictError(e error) bool {
+	var appErr *AppError
+	return errors.As(e, &appErr) && (appErr.Status == http.StatusConflict || appErr.ConflictData != nil)
+}
+
+// ConflictData returns the conflict data of the error, if the API returned any.
+func ConflictData(e error) (any, bool) {
+	var appErr *AppError
+	if errors.As(e, &appErr) && appErr.ConflictData != nil {
+		return *appErr.ConflictData, true
+	}
+	return nil, false
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type EntityOwner struct {
+	ID   string `json:"id" tf:"id"`
+	Type string `json:"type" tf:"type"`
+}
+
+func (o *EntityOwner) Encode() (tf.M, error) {
+	return tf.Encode(o)
+}
+
+type GlobalEventRuleAction struct {
+	RouteTo string `json:"route_to"`
+}
+
+type GlobalEventRuleset struct {
+	ID             uint                   `json:"id" tf:"id"`
+	Name           string                 `json:"name" tf:"name"`
+	Description    string                 `json:"description" tf:"description"`
+	OwnerID        string                 `json:"owner_id" tf:"team_id"`
+	EntityOwner    *EntityOwner           `json:"entity_owner" tf:"-"`
+	RoutingKey     string                 `json:"routing_key" tf:"routing_key"`
+	CatchAllAction *GlobalEventRuleAction `json:"catch_all_action" tf:"-"`
+
+	IngestionEndpoint string `json:"-" tf:"ingestion_endpoint"`
+}
+
+// Endpoint is the URL to which alerts have to be sent for them to be evaluated by the ruleset.
+func (r *GlobalEventRuleset) Endpoint(ingestionBaseURL string) string {
+	return fmt.Sprintf("%s/v2/incidents/global-event-rules/%s", ingestionBaseURL, r.RoutingKey)
+}
+
+func (r *GlobalEventRuleset) Encode() (tf.M, error) {
+	m, err := tf.Encode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = fmt.Sprint(r.ID)
+
+	m["entity_owner"] = []any{}
+	if r.EntityOwner != nil && r.EntityOwner.ID != "" {
+		owner, err := r.EntityOwner.Encode()
+		if err != nil {
+			return nil, err
+		}
+		m["entity_owner"] = tf.List(owner)
+	}
+
+	m["catch_all_route_to"] = ""
+	if r.CatchAllAction != nil {
+		m["catch_all_route_to"] = r.CatchAllAction.RouteTo
+	}
+
+	return m, nil
+}
+
+type GlobalEventRule struct {
+	ID          uint                  `json:"id" tf:"id"`
+	RulesetID   uint                  `json:"global_event_rule_id" tf:"-"`
+	Description string                `json:"description" tf:"description"`
+	Expression  string                `json:"expression" tf:"expression"`
+	Action      GlobalEventRuleAction `json:"action" tf:"-"`
+	Position    int                   `json:"-" tf:"position"`
+}
+
+func (r *GlobalEventRule) Encode() (tf.M, error) {
+	m, err := tf.Encode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = fmt.Sprint(r.ID)
+	m["ruleset_id"] = fmt.Sprint(r.RulesetID)
+	m["route_to"] = r.Action.RouteTo
+
+	return m, nil
+}
+
+func (client *Client) GetGlobalEventRulesetById(ctx context.Context, id string) (*GlobalEventRuleset, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s", client.BaseURLV3, id)
+
+	return Request[any, GlobalEventRuleset](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateGlobalEventRulesetReq struct {
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	OwnerID        string                 `json:"owner_id"`
+	OwnerType      string                 `json:"owner_type"`
+	EntityOwner    *EntityOwner           `json:"entity_owner,omitempty"`
+	CatchAllAction *GlobalEventRuleAction `json:"catch_all_action,omitempty"`
+}
+
+type UpdateGlobalEventRulesetReq struct {
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	EntityOwner    *EntityOwner           `json:"entity_owner"`
+	CatchAllAction *GlobalEventRuleAction `json:"catch_all_action"`
+}
+
+func (client *Client) CreateGlobalEventRuleset(ctx context.Context, req *CreateGlobalEventRulesetReq) (*GlobalEventRuleset, error) {
+	url := fmt.Sprintf("%s/global-event-rules", client.BaseURLV3)
+
+	return Request[CreateGlobalEventRulesetReq, GlobalEventRuleset](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateGlobalEventRuleset(ctx context.Context, id string, req *UpdateGlobalEventRulesetReq) (*GlobalEventRuleset, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s", client.BaseURLV3, id)
+
+	return Request[UpdateGlobalEventRulesetReq, GlobalEventRuleset](http.MethodPatch, url, client, ctx, req)
+}
+
+func (client *Client) DeleteGlobalEventRuleset(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
+
+// ListGlobalEventRules returns the rules of a ruleset, in the order in which they are evaluated.
+func (client *Client) ListGlobalEventRules(ctx context.Context, rulesetID string) ([]*GlobalEventRule, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s/rules", client.BaseURLV3, rulesetID)
+
+	rules, err := RequestSlice[any, GlobalEventRule](http.MethodGet, url, client, ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, rule := range rules {
+		rule.Position = i
+	}
+
+	return rules, nil
+}
+
+func (client *Client) GetGlobalEventRuleById(ctx context.Context, rulesetID string, id string) (*GlobalEventRule, error) {
+	rules, err := client.ListGlobalEventRules(ctx, rulesetID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if fmt.Sprint(rule.ID) == id {
+			return rule, nil
+		}
+	}
+
+	return nil, fmt.Errorf("[404] could not find global event rule with the id: %s", id)
+}
+
+type CreateUpdateGlobalEventRuleReq struct {
+	Description string                `json:"description"`
+	Expression  string                `json:"expression"`
+	Action      GlobalEventRuleAction `json:"action"`
+}
+
+func (client *Client) CreateGlobalEventRule(ctx context.Context, rulesetID string, req *CreateUpdateGlobalEventRuleReq) (*GlobalEventRule, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s/rules", client.BaseURLV3, rulesetID)
+
+	return Request[CreateUpdateGlobalEventRuleReq, GlobalEventRule](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateGlobalEventRule(ctx context.Context, rulesetID string, id string, req *CreateUpdateGlobalEventRuleReq) (*GlobalEventRule, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s/rules/%s", client.BaseURLV3, rulesetID, id)
+
+	return Request[CreateUpdateGlobalEventRuleReq, GlobalEventRule](http.MethodPatch, url, client, ctx, req)
+}
+
+func (client *Client) DeleteGlobalEventRule(ctx context.Context, rulesetID string, id string) (*any, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s/rules/%s", client.BaseURLV3, rulesetID, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
+
+type UpdateGlobalEventRulesOrderingReq struct {
+	Ordering []uint `json:"ordering"`
+}
+
+func (client *Client) UpdateGlobalEventRulesOrdering(ctx context.Context, rulesetID string, req *UpdateGlobalEventRulesOrderingReq) (*any, error) {
+	url := fmt.Sprintf("%s/global-event-rules/%s/rules/priority", client.BaseURLV3, rulesetID)
+
+	return Request[UpdateGlobalEventRulesOrderingReq, any](http.MethodPatch, url, client, ctx, req)
+}
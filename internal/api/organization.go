@@ -4,12 +4,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 type Organization struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
-	Slug string `json:"slug"`
+	ID   string `json:"id" tf:"id"`
+	Name string `json:"name" tf:"name"`
+	Slug string `json:"slug" tf:"slug"`
+}
+
+func (o *Organization) Encode() (tf.M, error) {
+	return tf.Encode(o)
 }
 
 func (client *Client) GetCurrentOrganization(ctx context.Context) (*Organization, error) {
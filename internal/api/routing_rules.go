@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
@@ -17,6 +18,10 @@ func (c *RoutingRuleCondition) Encode() (tf.M, error) {
 	return tf.Encode(c)
 }
 
+func (c *RoutingRuleCondition) Condition() expression.Condition {
+	return expression.Condition{LHS: c.LHS, Op: expression.OpIs, RHS: c.RHS}
+}
+
 type RouteTo struct {
 	EntityID   string `json:"entity_id" tf:"route_to_id"`
 	EntityType string `json:"entity_type" tf:"route_to_type"`
@@ -40,6 +45,7 @@ func (r *RoutingRule) Encode() (tf.M, error) {
 		return nil, err
 	}
 	m["basic_expressions"] = basicExpression
+	m["compiled_expression"] = compileRuleExpression(r.IsBasic, r.Expression, r.BasicExpression)
 
 	return m, nil
 }
@@ -0,0 +1,32 @@
+package api
+
+import "github.com/squadcast/terraform-provider-squadcast/internal/expression"
+
+type RuleCondition interface {
+	Condition() expression.Condition
+}
+
+// RuleExpression returns the expression of an advanced rule, or the expression which is equivalent to the conditions of a basic rule.
+func RuleExpression[T RuleCondition](isBasic bool, expr string, conditions []T) (string, error) {
+	if !isBasic {
+		return expr, nil
+	}
+
+	econditions := make([]expression.Condition, len(conditions))
+	for i, c := range conditions {
+		econditions[i] = c.Condition()
+	}
+
+	return expression.Compile(econditions)
+}
+
+// compileRuleExpression returns the expression of the rule, or an empty expression when the conditions
+// of a basic rule cannot be compiled, e.g. when one of them uses an unknown op. Rules read from the API
+// may have been created in the UI, so they are not rejected.
+func compileRuleExpression[T RuleCondition](isBasic bool, expr string, conditions []T) string {
+	compiled, err := RuleExpression(isBasic, expr, conditions)
+	if err != nil {
+		return ""
+	}
+	return compiled
+}
@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ServiceAlertSources struct {
+	AlertSourceIDs []string `json:"alert_source_ids"`
+}
+
+func (client *Client) GetServiceAlertSources(ctx context.Context, serviceID string) (*ServiceAlertSources, error) {
+	url := fmt.Sprintf("%s/services/%s/alert-sources", client.BaseURLV3, serviceID)
+
+	return Request[any, ServiceAlertSources](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateServiceAlertSourcesReq struct {
+	AlertSourceIDs []string `json:"alert_source_ids"`
+}
+
+func (client *Client) UpdateServiceAlertSources(ctx context.Context, serviceID string, req *UpdateServiceAlertSourcesReq) (*ServiceAlertSources, error) {
+	url := fmt.Sprintf("%s/services/%s/alert-sources", client.BaseURLV3, serviceID)
+
+	return Request[UpdateServiceAlertSourcesReq, ServiceAlertSources](http.MethodPut, url, client, ctx, req)
+}
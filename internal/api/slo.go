@@ -2,9 +2,9 @@ package api
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
@@ -26,6 +26,7 @@ type Slo struct {
 	DurationInDays      int                   `json:"duration_in_days,omitempty" tf:"duration_in_days"`
 	SloMonitoringChecks []*SloMonitoringCheck `json:"slo_monitoring_checks" tf:"rules"`
 	SloActions          []*SloAction          `json:"slo_actions" tf:"notify"`
+	OwnerType           string                `json:"owner_type"`
 	OwnerID             string                `json:"owner_id" tf:"team_id"`
 }
 
@@ -37,6 +38,15 @@ type SloMonitoringCheck struct {
 	IsChecked bool   `json:"is_checked" tf:"is_checked"`
 }
 
+const (
+	// SloOwnerTypeTeam is the owner type of SLOs, which are always owned by a team.
+	SloOwnerTypeTeam = "team"
+
+	SloActionTypeUser    = "USER"
+	SloActionTypeSquad   = "SQUAD"
+	SloActionTypeService = "SERVICE"
+)
+
 type SloAction struct {
 	ID        uint   `json:"id,omitempty" tf:"id"`
 	SloID     int64  `json:"slo_id,omitempty" tf:"slo_id"`
@@ -46,12 +56,39 @@ type SloAction struct {
 	ServiceID string `json:"service_id" tf:"service_id"`
 }
 
+// NewSloAction returns the action which notifies the given user, squad or service.
+func NewSloAction(actionType, targetID string) *SloAction {
+	action := &SloAction{Type: actionType}
+	switch actionType {
+	case SloActionTypeUser:
+		action.UserID = targetID
+	case SloActionTypeSquad:
+		action.SquadID = targetID
+	case SloActionTypeService:
+		action.ServiceID = targetID
+	}
+	return action
+}
+
+// TargetID returns the id of the user, squad or service notified by the action.
+func (a *SloAction) TargetID() string {
+	switch a.Type {
+	case SloActionTypeUser:
+		return a.UserID
+	case SloActionTypeSquad:
+		return a.SquadID
+	case SloActionTypeService:
+		return a.ServiceID
+	}
+	return ""
+}
+
 type SloNotify struct {
-	ID        uint     `json:"id,omitempty" tf:"id"`
-	SloID     int64    `json:"slo_id,omitempty" tf:"slo_id"`
-	UserIDs   []string `json:"user_ids" tf:"user_ids"`
-	SquadIDs  []string `json:"squad_ids" tf:"squad_ids"`
-	ServiceID string   `json:"service_id" tf:"service_id"`
+	ID         uint     `json:"id,omitempty" tf:"id"`
+	SloID      int64    `json:"slo_id,omitempty" tf:"slo_id"`
+	UserIDs    []string `json:"user_ids" tf:"user_ids"`
+	SquadIDs   []string `json:"squad_ids" tf:"squad_ids"`
+	ServiceIDs []string `json:"service_ids" tf:"service_ids"`
 }
 
 func (c *SloMonitoringCheck) Encode() (map[string]interface{}, error) {
@@ -63,11 +100,6 @@ func (c *SloNotify) Encode() (map[string]interface{}, error) {
 }
 
 func (r *Slo) Encode() (map[string]interface{}, error) {
-	notify := make([]*SloNotify, 0)
-	// Max item limit set to 1 for `notify` rule,
-	// However, notify type is still a list. so we are taking slice
-	notify = append(notify, &SloNotify{})
-
 	m, err := tf.Encode(r)
 	if err != nil {
 		return nil, err
@@ -79,26 +111,32 @@ func (r *Slo) Encode() (map[string]interface{}, error) {
 	}
 	m["rules"] = sloMonitoringChecks
 
-	for _, n := range r.SloActions {
-		if n.UserID != "" {
-			notify[0].UserIDs = append(notify[0].UserIDs, n.UserID)
-		}
-		if n.SquadID != "" {
-			notify[0].SquadIDs = append(notify[0].SquadIDs, n.SquadID)
-		}
-		if n.ServiceID != "" {
-			notify[0].ServiceID = n.ServiceID
-		}
+	// `notify` is a single block which groups the actions by their type.
+	m["notify"] = []any{}
+	if len(r.SloActions) == 0 {
+		return m, nil
 	}
 
-	if len(r.SloActions) > 0 {
-		notify[0].SloID = int64(r.ID)
+	notify := &SloNotify{
+		SloID:      int64(r.ID),
+		UserIDs:    []string{},
+		SquadIDs:   []string{},
+		ServiceIDs: []string{},
+	}
+	for _, action := range r.SloActions {
+		switch action.Type {
+		case SloActionTypeUser:
+			notify.UserIDs = append(notify.UserIDs, action.UserID)
+		case SloActionTypeSquad:
+			notify.SquadIDs = append(notify.SquadIDs, action.SquadID)
+		case SloActionTypeService:
+			notify.ServiceIDs = append(notify.ServiceIDs, action.ServiceID)
+		}
 	}
 
-	notifyObj, err := tf.EncodeSlice(notify)
+	notifyObj, err := tf.EncodeSlice([]*SloNotify{notify})
 	if err != nil {
-		fmt.Println(err)
-
+		return nil, err
 	}
 	m["notify"] = notifyObj
 
@@ -120,8 +158,8 @@ func (r *Data) Encode() (map[string]interface{}, error) {
 	return m, nil
 }
 
-func (client *Client) CreateSlo(ctx context.Context, orgID, ownerID string, req *Slo) (*Slo, error) {
-	url := fmt.Sprintf("%s/slo?owner_type=team&owner_id=%s", client.BaseURLV3, ownerID)
+func (client *Client) CreateSlo(ctx context.Context, ownerID string, req *Slo) (*Slo, error) {
+	url := fmt.Sprintf("%s/slo?owner_type=%s&owner_id=%s", client.BaseURLV3, SloOwnerTypeTeam, ownerID)
 	data, err := Request[Slo, Data](http.MethodPost, url, client, ctx, req)
 	if err != nil {
 		return nil, err
@@ -129,21 +167,74 @@ func (client *Client) CreateSlo(ctx context.Context, orgID, ownerID string, req
 	return data.Slo, err
 }
 
-func (client *Client) GetSlo(ctx context.Context, orgID, ownerID, sloID string) (*Slo, error) {
-	url := fmt.Sprintf("%s/slo/%s?owner_type=team&owner_id=%s", client.BaseURLV3, sloID, ownerID)
+func (client *Client) GetSlo(ctx context.Context, ownerID, sloID string) (*Slo, error) {
+	url := fmt.Sprintf("%s/slo/%s?owner_type=%s&owner_id=%s", client.BaseURLV3, sloID, SloOwnerTypeTeam, ownerID)
 	data, err := Request[any, Data](http.MethodGet, url, client, ctx, nil)
 	if err != nil {
-		return nil, errors.New("Slo not found")
+		return nil, err
+	}
+	if data.Slo == nil {
+		return nil, fmt.Errorf("[404] could not find slo with the id: %s", sloID)
 	}
 	return data.Slo, nil
 }
 
-func (client *Client) UpdateSlo(ctx context.Context, orgID, ownerID, sloID string, req *Slo) (*Slo, error) {
-	url := fmt.Sprintf("%s/slo/%s?owner_type=team&owner_id=%s", client.BaseURLV3, sloID, ownerID)
+type ListSlosRes struct {
+	Slos []*Slo `json:"slos"`
+}
+
+func (client *Client) ListSlos(ctx context.Context, ownerID string) ([]*Slo, error) {
+	url := fmt.Sprintf("%s/slo?owner_type=%s&owner_id=%s", client.BaseURLV3, SloOwnerTypeTeam, ownerID)
+	data, err := Request[any, ListSlosRes](http.MethodGet, url, client, ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return data.Slos, nil
+}
+
+func (client *Client) GetSloByName(ctx context.Context, ownerID, name string) (*Slo, error) {
+	slos, err := client.ListSlos(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, slo := range slos {
+		if slo.Name == name {
+			return slo, nil
+		}
+	}
+
+	return nil, fmt.Errorf("[404] could not find slo with the name `%s` in team %s", name, ownerID)
+}
+
+func (client *Client) UpdateSlo(ctx context.Context, ownerID, sloID string, req *Slo) (*Slo, error) {
+	url := fmt.Sprintf("%s/slo/%s?owner_type=%s&owner_id=%s", client.BaseURLV3, sloID, SloOwnerTypeTeam, ownerID)
 	return Request[Slo, Slo](http.MethodPut, url, client, ctx, req)
 }
 
-func (client *Client) DeleteSlo(ctx context.Context, orgID, ownerID, sloID string) (*any, error) {
-	url := fmt.Sprintf("%s/slo/%s?owner_type=team&owner_id=%s", client.BaseURLV3, sloID, ownerID)
+func (client *Client) DeleteSlo(ctx context.Context, ownerID, sloID string) (*any, error) {
+	url := fmt.Sprintf("%s/slo/%s?owner_type=%s&owner_id=%s", client.BaseURLV3, sloID, SloOwnerTypeTeam, ownerID)
 	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
 }
+
+type SloIncident struct {
+	ID              uint       `json:"id"`
+	SloID           int64      `json:"slo_id"`
+	IncidentID      string     `json:"incident_id"`
+	IsFalsePositive bool       `json:"is_false_positive"`
+	CreatedAt       time.Time  `json:"created_at"`
+	ResolvedAt      *time.Time `json:"resolved_at"`
+}
+
+type ListSloIncidentsRes struct {
+	Incidents []*SloIncident `json:"incidents"`
+}
+
+func (client *Client) ListSloIncidents(ctx context.Context, ownerID, sloID string, from, to time.Time) ([]*SloIncident, error) {
+	url := fmt.Sprintf("%s/slo/%s/incidents?owner_type=%s&owner_id=%s&from=%s&to=%s", client.BaseURLV3, sloID, SloOwnerTypeTeam, ownerID, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339))
+	data, err := Request[any, ListSloIncidentsRes](http.MethodGet, url, client, ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return data.Incidents, nil
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+type StatusPageThemeColor struct {
+	Primary   string `json:"primary" tf:"primary"`
+	Secondary string `json:"secondary" tf:"secondary"`
+}
+
+func (c *StatusPageThemeColor) Encode() (tf.M, error) {
+	return tf.Encode(c)
+}
+
+type StatusPageComponentGroup struct {
+	ID           uint   `json:"id" tf:"id"`
+	StatusPageID uint   `json:"pageID" tf:"-"`
+	Name         string `json:"name" tf:"name"`
+	Description  string `json:"description" tf:"description"`
+}
+
+func (g *StatusPageComponentGroup) Encode() (tf.M, error) {
+	m, err := tf.Encode(g)
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = fmt.Sprint(g.ID)
+	m["status_page_id"] = fmt.Sprint(g.StatusPageID)
+
+	return m, nil
+}
+
+type StatusPageComponent struct {
+	ID           uint   `json:"id" tf:"id"`
+	StatusPageID uint   `json:"pageID" tf:"-"`
+	GroupID      uint   `json:"groupID,omitempty" tf:"-"`
+	Name         string `json:"name" tf:"name"`
+	Description  string `json:"description" tf:"description"`
+	ServiceID    string `json:"serviceID,omitempty" tf:"service_id"`
+}
+
+func (c *StatusPageComponent) Encode() (tf.M, error) {
+	m, err := tf.Encode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = fmt.Sprint(c.ID)
+	m["status_page_id"] = fmt.Sprint(c.StatusPageID)
+	m["group_id"] = ""
+	if c.GroupID != 0 {
+		m["group_id"] = fmt.Sprint(c.GroupID)
+	}
+
+	return m, nil
+}
+
+type StatusPage struct {
+	ID                    uint                        `json:"id" tf:"id"`
+	Name                  string                      `json:"name" tf:"name"`
+	Description           string                      `json:"description" tf:"description"`
+	IsPublic              bool                        `json:"isPublic" tf:"is_public"`
+	DomainName            string                      `json:"domainName" tf:"domain_name"`
+	CustomDomainName      string                      `json:"customDomainName" tf:"custom_domain_name"`
+	URL                   string                      `json:"url" tf:"url"`
+	Timezone              string                      `json:"timezone" tf:"timezone"`
+	LogoURL               string                      `json:"logoUrl" tf:"logo_url"`
+	ContactEmail          string                      `json:"contactEmail" tf:"contact_email"`
+	HideFromSearchEngines bool                        `json:"hideFromSearchEngines" tf:"hide_from_search_engines"`
+	ThemeColor            StatusPageThemeColor        `json:"themeColor" tf:"-"`
+	OwnerID               string                      `json:"ownerID" tf:"team_id"`
+	Components            []*StatusPageComponent      `json:"components" tf:"-"`
+	ComponentGroups       []*StatusPageComponentGroup `json:"groups" tf:"-"`
+}
+
+func (s *StatusPage) Encode() (tf.M, error) {
+	m, err := tf.Encode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m["id"] = fmt.Sprint(s.ID)
+
+	themeColor, err := s.ThemeColor.Encode()
+	if err != nil {
+		return nil, err
+	}
+	m["theme_color"] = tf.List(themeColor)
+
+	return m, nil
+}
+
+// EncodeWithComponents is used by the data source, which also exposes the components and their groups.
+func (s *StatusPage) EncodeWithComponents() (tf.M, error) {
+	m, err := s.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := tf.EncodeSlice(s.Components)
+	if err != nil {
+		return nil, err
+	}
+	m["components"] = components
+
+	groups, err := tf.EncodeSlice(s.ComponentGroups)
+	if err != nil {
+		return nil, err
+	}
+	m["component_groups"] = groups
+
+	return m, nil
+}
+
+func (client *Client) GetStatusPageById(ctx context.Context, teamID string, id string) (*StatusPage, error) {
+	url := fmt.Sprintf("%s/statuspages/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, StatusPage](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) GetStatusPageByName(ctx context.Context, teamID string, name string) (*StatusPage, error) {
+	statusPages, err := client.ListStatusPages(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range statusPages {
+		if s.Name == name {
+			return client.GetStatusPageById(ctx, teamID, fmt.Sprint(s.ID))
+		}
+	}
+
+	return nil, fmt.Errorf("could not find a status page with name `%s`", name)
+}
+
+func (client *Client) ListStatusPages(ctx context.Context, teamID string) ([]*StatusPage, error) {
+	url := fmt.Sprintf("%s/statuspages?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, StatusPage](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateStatusPageReq struct {
+	Name                  string               `json:"name"`
+	Description           string               `json:"description"`
+	TeamID                string               `json:"ownerID"`
+	IsPublic              bool                 `json:"isPublic"`
+	DomainName            string               `json:"domainName"`
+	CustomDomainName      string               `json:"customDomainName,omitempty"`
+	Timezone              string               `json:"timezone"`
+	LogoURL               string               `json:"logoUrl,omitempty"`
+	ContactEmail          string               `json:"contactEmail,omitempty"`
+	HideFromSearchEngines bool                 `json:"hideFromSearchEngines"`
+	ThemeColor            StatusPageThemeColor `json:"themeColor"`
+}
+
+func (client *Client) CreateStatusPage(ctx context.Context, req *CreateUpdateStatusPageReq) (*StatusPage, error) {
+	url := fmt.Sprintf("%s/statuspages", client.BaseURLV3)
+
+	return Request[CreateUpdateStatusPageReq, StatusPage](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateStatusPage(ctx context.Context, id string, req *CreateUpdateStatusPageReq) (*StatusPage, error) {
+	url := fmt.Sprintf("%s/statuspages/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateStatusPageReq, StatusPage](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStatusPage(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
+
+func (client *Client) GetStatusPageComponentGroupById(ctx context.Context, statusPageID string, id string) (*StatusPageComponentGroup, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/groups/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[any, StatusPageComponentGroup](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateStatusPageComponentGroupReq struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (client *Client) CreateStatusPageComponentGroup(ctx context.Context, statusPageID string, req *CreateUpdateStatusPageComponentGroupReq) (*StatusPageComponentGroup, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/groups", client.BaseURLV3, statusPageID)
+
+	return Request[CreateUpdateStatusPageComponentGroupReq, StatusPageComponentGroup](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateStatusPageComponentGroup(ctx context.Context, statusPageID string, id string, req *CreateUpdateStatusPageComponentGroupReq) (*StatusPageComponentGroup, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/groups/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[CreateUpdateStatusPageComponentGroupReq, StatusPageComponentGroup](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStatusPageComponentGroup(ctx context.Context, statusPageID string, id string) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/groups/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
+
+func (client *Client) GetStatusPageComponentById(ctx context.Context, statusPageID string, id string) (*StatusPageComponent, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/components/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[any, StatusPageComponent](http.MethodGet, url, client, ctx, nil)
+}
+
+// CreateUpdateStatusPageComponentReq is the request to create or update a component.
+// GroupID and ServiceID are sent as null when they are not set, which removes the component from its group or service on update.
+type CreateUpdateStatusPageComponentReq struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	GroupID     *uint   `json:"groupID"`
+	ServiceID   *string `json:"serviceID"`
+}
+
+func (client *Client) CreateStatusPageComponent(ctx context.Context, statusPageID string, req *CreateUpdateStatusPageComponentReq) (*StatusPageComponent, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/components", client.BaseURLV3, statusPageID)
+
+	return Request[CreateUpdateStatusPageComponentReq, StatusPageComponent](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateStatusPageComponent(ctx context.Context, statusPageID string, id string, req *CreateUpdateStatusPageComponentReq) (*StatusPageComponent, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/components/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[CreateUpdateStatusPageComponentReq, StatusPageComponent](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteStatusPageComponent(ctx context.Context, statusPageID string, id string) (*any, error) {
+	url := fmt.Sprintf("%s/statuspages/%s/components/%s", client.BaseURLV3, statusPageID, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
@@ -18,6 +19,10 @@ func (c *SuppressionRuleCondition) Encode() (tf.M, error) {
 	return tf.Encode(c)
 }
 
+func (c *SuppressionRuleCondition) Condition() expression.Condition {
+	return expression.Condition{LHS: c.LHS, Op: c.Op, RHS: c.RHS}
+}
+
 type SuppressionRule struct {
 	IsBasic         bool                        `json:"is_basic" tf:"is_basic"`
 	Description     string                      `json:"description" tf:"description"`
@@ -36,6 +41,7 @@ func (r *SuppressionRule) Encode() (tf.M, error) {
 		return nil, err
 	}
 	m["basic_expressions"] = basicExpression
+	m["compiled_expression"] = compileRuleExpression(r.IsBasic, r.Expression, r.BasicExpression)
 
 	return m, nil
 }
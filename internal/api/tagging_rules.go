@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sort"
 
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
@@ -19,6 +20,10 @@ func (c *TaggingRuleCondition) Encode() (tf.M, error) {
 	return tf.Encode(c)
 }
 
+func (c *TaggingRuleCondition) Condition() expression.Condition {
+	return expression.Condition{LHS: c.LHS, Op: c.Op, RHS: c.RHS}
+}
+
 type TaggingRuleTagValue struct {
 	Value string `json:"value" tf:"value"`
 	Color string `json:"color" tf:"color"`
@@ -46,6 +51,7 @@ func (r *TaggingRule) Encode() (tf.M, error) {
 		return nil, err
 	}
 	m["basic_expressions"] = basicExpression
+	m["compiled_expression"] = compileRuleExpression(r.IsBasic, r.Expression, r.BasicExpression)
 
 	tags := make([]any, 0, len(r.Tags))
 
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// Tag is a tag of the tag settings of the organization. A tag without values or colors accepts any value or color.
+type Tag struct {
+	ID     string   `json:"id" tf:"id"`
+	Key    string   `json:"key" tf:"key"`
+	Values []string `json:"values" tf:"values"`
+	Colors []string `json:"colors" tf:"colors"`
+}
+
+func (t *Tag) Encode() (tf.M, error) {
+	return tf.Encode(t)
+}
+
+func (client *Client) GetTagById(ctx context.Context, id string) (*Tag, error) {
+	url := fmt.Sprintf("%s/organization/tag-settings/%s", client.BaseURLV3, id)
+
+	return Request[any, Tag](http.MethodGet, url, client, ctx, nil)
+}
+
+// GetTagByKey returns the tag with the given key, or whose key only differs by case.
+func (client *Client) GetTagByKey(ctx context.Context, key string) (*Tag, error) {
+	tags, err := client.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var folded *Tag
+	for _, t := range tags {
+		if t.Key == key {
+			return t, nil
+		}
+		if strings.EqualFold(t.Key, key) {
+			folded = t
+		}
+	}
+	if folded != nil {
+		return folded, nil
+	}
+
+	return nil, fmt.Errorf("could not find a tag with key `%s`", key)
+}
+
+func (client *Client) ListTags(ctx context.Context) ([]*Tag, error) {
+	url := fmt.Sprintf("%s/organization/tag-settings", client.BaseURLV3)
+
+	return RequestSlice[any, Tag](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateTagReq struct {
+	Key    string   `json:"key"`
+	Values []string `json:"values"`
+	Colors []string `json:"colors"`
+}
+
+func (client *Client) CreateTag(ctx context.Context, req *CreateUpdateTagReq) (*Tag, error) {
+	url := fmt.Sprintf("%s/organization/tag-settings", client.BaseURLV3)
+
+	return Request[CreateUpdateTagReq, Tag](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateTag(ctx context.Context, id string, req *CreateUpdateTagReq) (*Tag, error) {
+	url := fmt.Sprintf("%s/organization/tag-settings/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateTagReq, Tag](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteTag(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/organization/tag-settings/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -96,6 +96,12 @@ func (client *Client) GetTeamByName(ctx context.Context, name string) (*Team, er
 	return Request[any, Team](http.MethodGet, url, client, ctx, nil)
 }
 
+func (client *Client) ListTeams(ctx context.Context) ([]*Team, error) {
+	url := fmt.Sprintf("%s/teams", client.BaseURLV3)
+
+	return RequestSlice[any, Team](http.MethodGet, url, client, ctx, nil)
+}
+
 func (client *Client) GetTeamById(ctx context.Context, id string) (*Team, error) {
 	url := fmt.Sprintf("%s/teams/%s", client.BaseURLV3, id)
 
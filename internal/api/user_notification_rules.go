@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var PersonalNotificationRuleTypes = []string{"Email", "Push", "SMS", "Phone"}
+
+var OncallReminderRuleTypes = []string{"Email", "Push"}
+
+type UserNotificationRules struct {
+	UserID                    string                      `json:"id" tf:"user_id"`
+	PersonalNotificationRules []*PersonalNotificationRule `json:"notification_rules" tf:"-"`
+	OncallReminderRules       []*OncallReminderRule       `json:"oncall_reminder_rules" tf:"-"`
+}
+
+func (r *UserNotificationRules) Encode() (tf.M, error) {
+	m, err := tf.Encode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := tf.EncodeSlice(r.PersonalNotificationRules)
+	if err != nil {
+		return nil, err
+	}
+	m["notification_rules"] = rules
+
+	rules, err = tf.EncodeSlice(r.OncallReminderRules)
+	if err != nil {
+		return nil, err
+	}
+	m["oncall_reminder_rules"] = rules
+
+	return m, nil
+}
+
+func (client *Client) GetUserNotificationRules(ctx context.Context, userID string) (*UserNotificationRules, error) {
+	url := fmt.Sprintf("%s/users/%s", client.BaseURLV3, userID)
+
+	return Request[any, UserNotificationRules](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateUserNotificationRulesReq struct {
+	PersonalNotificationRules []*PersonalNotificationRule `json:"notification_rules"`
+	OncallReminderRules       []*OncallReminderRule       `json:"oncall_reminder_rules"`
+}
+
+func (client *Client) UpdateUserNotificationRules(ctx context.Context, userID string, req *UpdateUserNotificationRulesReq) (*UserNotificationRules, error) {
+	url := fmt.Sprintf("%s/users/%s/notification-rules", client.BaseURLV3, userID)
+
+	return Request[UpdateUserNotificationRulesReq, UserNotificationRules](http.MethodPut, url, client, ctx, req)
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// twoDigitCallingCodes are the two digit country calling codes. Country calling codes are prefix free,
+// so a number starting with 1 or 7 has a one digit code, a number starting with one of these has a
+// two digit code and every other number has a three digit code.
+var twoDigitCallingCodes = map[string]bool{
+	"20": true, "27": true, "30": true, "31": true, "32": true, "33": true, "34": true, "36": true,
+	"39": true, "40": true, "41": true, "43": true, "44": true, "45": true, "46": true, "47": true,
+	"48": true, "49": true, "51": true, "52": true, "53": true, "54": true, "55": true, "56": true,
+	"57": true, "58": true, "60": true, "61": true, "62": true, "63": true, "64": true, "65": true,
+	"66": true, "81": true, "82": true, "84": true, "86": true, "90": true, "91": true, "92": true,
+	"93": true, "94": true, "95": true, "98": true,
+}
+
+// SplitPhoneNumber splits a phone number in E.164 format into its dial code and subscriber number,
+// e.g. "+14155552671" into "+1" and "4155552671".
+func SplitPhoneNumber(phone string) (string, string, error) {
+	digits := strings.TrimPrefix(phone, "+")
+	if len(digits) == len(phone) || len(digits) < 4 {
+		return "", "", fmt.Errorf("invalid phone number %q, expected E.164 format", phone)
+	}
+
+	n := 3
+	switch {
+	case digits[0] == '1' || digits[0] == '7':
+		n = 1
+	case twoDigitCallingCodes[digits[:2]]:
+		n = 2
+	}
+
+	return "+" + digits[:n], digits[n:], nil
+}
+
+type UserProfile struct {
+	ID              string  `json:"id" tf:"user_id"`
+	Contact         Contact `json:"contact" tf:"-"`
+	PhoneNumber     string  `json:"-" tf:"phone"`
+	TimeZone        string  `json:"time_zone" tf:"time_zone"`
+	Title           string  `json:"title" tf:"title"`
+	Bio             string  `json:"bio" tf:"bio"`
+	IsEmailVerified bool    `json:"email_verified" tf:"is_email_verified"`
+	IsPhoneVerified bool    `json:"phone_verified" tf:"is_phone_verified"`
+}
+
+func (p *UserProfile) Encode() (tf.M, error) {
+	if p.Contact.DialCode != "" && p.Contact.PhoneNumber != "" {
+		p.PhoneNumber = p.Contact.DialCode + p.Contact.PhoneNumber
+	}
+
+	return tf.Encode(p)
+}
+
+func (client *Client) GetUserProfile(ctx context.Context, userID string) (*UserProfile, error) {
+	url := fmt.Sprintf("%s/users/%s", client.BaseURLV3, userID)
+
+	return Request[any, UserProfile](http.MethodGet, url, client, ctx, nil)
+}
+
+type UpdateUserProfileReq struct {
+	Contact  *Contact `json:"contact,omitempty"`
+	TimeZone string   `json:"time_zone,omitempty"`
+	Title    string   `json:"title"`
+	Bio      string   `json:"bio"`
+}
+
+func (client *Client) UpdateUserProfile(ctx context.Context, userID string, req *UpdateUserProfileReq) (*UserProfile, error) {
+	url := fmt.Sprintf("%s/users/%s/profile", client.BaseURLV3, userID)
+
+	return Request[UpdateUserProfileReq, UserProfile](http.MethodPut, url, client, ctx, req)
+}
@@ -0,0 +1,83 @@
+package api
+
+import "testing"
+
+func TestSplitPhoneNumber(t *testing.T) {
+	cases := []struct {
+		name            string
+		phone           string
+		wantDialCode    string
+		wantPhoneNumber string
+		wantErr         bool
+	}{
+		{
+			name:            "one digit dial code",
+			phone:           "+14155552671",
+			wantDialCode:    "+1",
+			wantPhoneNumber: "4155552671",
+		},
+		{
+			name:            "one digit dial code starting with 7",
+			phone:           "+74951234567",
+			wantDialCode:    "+7",
+			wantPhoneNumber: "4951234567",
+		},
+		{
+			name:            "two digit dial code",
+			phone:           "+447911123456",
+			wantDialCode:    "+44",
+			wantPhoneNumber: "7911123456",
+		},
+		{
+			name:            "two digit dial code of india",
+			phone:           "+919876543210",
+			wantDialCode:    "+91",
+			wantPhoneNumber: "9876543210",
+		},
+		{
+			name:            "three digit dial code",
+			phone:           "+353861234567",
+			wantDialCode:    "+353",
+			wantPhoneNumber: "861234567",
+		},
+		{
+			name:            "three digit dial code sharing its first digit with a two digit one",
+			phone:           "+971501234567",
+			wantDialCode:    "+971",
+			wantPhoneNumber: "501234567",
+		},
+		{
+			name:    "without plus sign",
+			phone:   "14155552671",
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			phone:   "",
+			wantErr: true,
+		},
+		{
+			name:    "too short",
+			phone:   "+123",
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			dialCode, phoneNumber, err := SplitPhoneNumber(c.phone)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q and %q", dialCode, phoneNumber)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if dialCode != c.wantDialCode || phoneNumber != c.wantPhoneNumber {
+				t.Fatalf("expected %q and %q, got %q and %q", c.wantDialCode, c.wantPhoneNumber, dialCode, phoneNumber)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var WebhookTriggers = []string{
+	"triggered",
+	"acknowledged",
+	"resolved",
+	"reassigned",
+	"priority_changed",
+	"tags_updated",
+	"note_added",
+}
+
+type WebhookHeader struct {
+	Key   string `json:"key" tf:"key"`
+	Value string `json:"value" tf:"value"`
+}
+
+func (h *WebhookHeader) Encode() (tf.M, error) {
+	return tf.Encode(h)
+}
+
+type WebhookTagFilter struct {
+	Key   string `json:"key" tf:"key"`
+	Value string `json:"value" tf:"value"`
+}
+
+func (f *WebhookTagFilter) Encode() (tf.M, error) {
+	return tf.Encode(f)
+}
+
+type WebhookFilters struct {
+	ServiceIDs []string            `json:"service_ids"`
+	Tags       []*WebhookTagFilter `json:"tags"`
+}
+
+type Webhook struct {
+	ID              string           `json:"id" tf:"id"`
+	Name            string           `json:"name" tf:"name"`
+	Description     string           `json:"description" tf:"description"`
+	URL             string           `json:"url" tf:"url"`
+	Headers         []*WebhookHeader `json:"headers" tf:"-"`
+	Triggers        []string         `json:"triggers" tf:"triggers"`
+	Filters         WebhookFilters   `json:"filters" tf:"-"`
+	PayloadTemplate string           `json:"custom_payload" tf:"payload_template"`
+	Owner           OwnerRef         `json:"owner" tf:"-"`
+}
+
+func (w *Webhook) Encode() (tf.M, error) {
+	m, err := tf.Encode(w)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := tf.EncodeSlice(w.Headers)
+	if err != nil {
+		return nil, err
+	}
+	m["headers"] = headers
+
+	tags, err := tf.EncodeSlice(w.Filters.Tags)
+	if err != nil {
+		return nil, err
+	}
+	m["tag_filters"] = tags
+
+	if w.Filters.ServiceIDs == nil {
+		m["service_ids"] = []string{}
+	} else {
+		m["service_ids"] = w.Filters.ServiceIDs
+	}
+
+	m["team_id"] = w.Owner.ID
+
+	return m, nil
+}
+
+func (client *Client) GetWebhookById(ctx context.Context, teamID string, id string) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks/%s?owner_id=%s", client.BaseURLV3, id, teamID)
+
+	return Request[any, Webhook](http.MethodGet, url, client, ctx, nil)
+}
+
+func (client *Client) ListWebhooks(ctx context.Context, teamID string) ([]*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks?owner_id=%s", client.BaseURLV3, teamID)
+
+	return RequestSlice[any, Webhook](http.MethodGet, url, client, ctx, nil)
+}
+
+type CreateUpdateWebhookReq struct {
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	TeamID          string           `json:"owner_id"`
+	URL             string           `json:"url"`
+	Headers         []*WebhookHeader `json:"headers"`
+	Triggers        []string         `json:"triggers"`
+	Filters         WebhookFilters   `json:"filters"`
+	PayloadTemplate string           `json:"custom_payload"`
+}
+
+func (client *Client) CreateWebhook(ctx context.Context, req *CreateUpdateWebhookReq) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks", client.BaseURLV3)
+
+	return Request[CreateUpdateWebhookReq, Webhook](http.MethodPost, url, client, ctx, req)
+}
+
+func (client *Client) UpdateWebhook(ctx context.Context, id string, req *CreateUpdateWebhookReq) (*Webhook, error) {
+	url := fmt.Sprintf("%s/webhooks/%s", client.BaseURLV3, id)
+
+	return Request[CreateUpdateWebhookReq, Webhook](http.MethodPut, url, client, ctx, req)
+}
+
+func (client *Client) DeleteWebhook(ctx context.Context, id string) (*any, error) {
+	url := fmt.Sprintf("%s/webhooks/%s", client.BaseURLV3, id)
+
+	return Request[any, any](http.MethodDelete, url, client, ctx, nil)
+}
@@ -0,0 +1,156 @@
+// Package errorbudget computes the attainment and error budget of an SLO from the incidents which violated it.
+package errorbudget
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	IntervalTypeRolling = "rolling"
+	IntervalTypeFixed   = "fixed"
+)
+
+// Window is the period over which an SLO is tracked.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// NewWindow returns the window of an SLO at the given time.
+// A rolling SLO covers the last durationInDays days, a fixed SLO covers the period between startTime and endTime (RFC3339).
+func NewWindow(intervalType string, durationInDays int, startTime, endTime string, now time.Time) (Window, error) {
+	switch intervalType {
+	case IntervalTypeRolling:
+		if durationInDays <= 0 {
+			return Window{}, fmt.Errorf("duration_in_days must be greater than 0 for a rolling SLO, got %d", durationInDays)
+		}
+		return Window{Start: now.AddDate(0, 0, -durationInDays), End: now}, nil
+	case IntervalTypeFixed:
+		start, err := time.Parse(time.RFC3339, startTime)
+		if err != nil {
+			return Window{}, fmt.Errorf("invalid start_time of a fixed SLO: %w", err)
+		}
+		end, err := time.Parse(time.RFC3339, endTime)
+		if err != nil {
+			return Window{}, fmt.Errorf("invalid end_time of a fixed SLO: %w", err)
+		}
+		if !end.After(start) {
+			return Window{}, fmt.Errorf("end_time (%s) must be after start_time (%s)", endTime, startTime)
+		}
+		return Window{Start: start, End: end}, nil
+	}
+
+	return Window{}, fmt.Errorf("unsupported time interval type %q, expected %q or %q", intervalType, IntervalTypeRolling, IntervalTypeFixed)
+}
+
+// Incident is an incident which was promoted as violating the SLO.
+type Incident struct {
+	Start time.Time
+	// End is zero as long as the incident is not resolved.
+	End           time.Time
+	FalsePositive bool
+}
+
+// Status is the state of an SLO at a point in time.
+type Status struct {
+	Window Window
+	// Attainment is the percentage of the elapsed part of the window during which the SLO was met.
+	Attainment float64
+	// ErrorBudget is the downtime allowed over the whole window.
+	ErrorBudget time.Duration
+	// RemainingErrorBudget is negative once the budget is exhausted.
+	RemainingErrorBudget time.Duration
+	// RemainingErrorBudgetPercent is the remaining error budget as a percentage of ErrorBudget.
+	RemainingErrorBudgetPercent float64
+	// BurnRate is how fast the budget is consumed relative to the rate which exactly exhausts it at the end of the window,
+	// e.g. 2 means that the budget runs out halfway through the window.
+	BurnRate float64
+	// ViolatingIncidents is the number of incidents, excluding false positives, which overlap the elapsed part of the window.
+	ViolatingIncidents int
+}
+
+// Compute returns the status of an SLO with the given target (in percent) at the given time.
+// Overlapping incidents are only counted once towards the consumed error budget.
+func Compute(target float64, window Window, incidents []Incident, now time.Time) (*Status, error) {
+	if target <= 0 || target >= 100 {
+		return nil, fmt.Errorf("target must be between 0 and 100 (exclusive), got %v", target)
+	}
+	if !window.End.After(window.Start) {
+		return nil, errors.New("window end must be after window start")
+	}
+
+	// Only the part of the window which already elapsed is observed.
+	observedEnd := window.End
+	if now.Before(observedEnd) {
+		observedEnd = now
+	}
+
+	status := &Status{
+		Window:      window,
+		ErrorBudget: time.Duration(float64(window.End.Sub(window.Start)) * (100 - target) / 100),
+	}
+
+	var intervals []Window
+	for _, incident := range incidents {
+		if incident.FalsePositive {
+			continue
+		}
+
+		start, end := incident.Start, incident.End
+		if end.IsZero() || end.After(observedEnd) {
+			end = observedEnd
+		}
+		if start.Before(window.Start) {
+			start = window.Start
+		}
+		if !end.After(start) {
+			continue
+		}
+
+		status.ViolatingIncidents++
+		intervals = append(intervals, Window{Start: start, End: end})
+	}
+
+	consumed := downtime(intervals)
+	status.RemainingErrorBudget = status.ErrorBudget - consumed
+	status.RemainingErrorBudgetPercent = float64(status.RemainingErrorBudget) / float64(status.ErrorBudget) * 100
+
+	status.Attainment = 100
+	if elapsed := observedEnd.Sub(window.Start); elapsed > 0 {
+		status.Attainment = float64(elapsed-consumed) / float64(elapsed) * 100
+		status.BurnRate = float64(consumed) / float64(elapsed) / ((100 - target) / 100)
+	}
+
+	return status, nil
+}
+
+// downtime returns the total duration covered by the intervals, counting overlapping intervals once.
+func downtime(intervals []Window) time.Duration {
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].Start.Before(intervals[j].Start)
+	})
+
+	var total time.Duration
+	var current *Window
+	for i := range intervals {
+		interval := intervals[i]
+		if current != nil && !interval.Start.After(current.End) {
+			if interval.End.After(current.End) {
+				current.End = interval.End
+			}
+			continue
+		}
+		if current != nil {
+			total += current.End.Sub(current.Start)
+		}
+		current = &interval
+	}
+	if current != nil {
+		total += current.End.Sub(current.Start)
+	}
+
+	return total
+}
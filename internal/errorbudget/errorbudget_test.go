@@ -0,0 +1,256 @@
+package errorbudget
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2022, 6, 30, 12, 0, 0, 0, time.UTC)
+
+func at(day, hour, minute int) time.Time {
+	return time.Date(2022, 6, day, hour, minute, 0, 0, time.UTC)
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestNewWindow(t *testing.T) {
+	cases := []struct {
+		name           string
+		intervalType   string
+		durationInDays int
+		startTime      string
+		endTime        string
+		want           Window
+		wantErr        bool
+	}{
+		{
+			name:           "rolling",
+			intervalType:   "rolling",
+			durationInDays: 7,
+			want:           Window{Start: at(23, 12, 0), End: now},
+		},
+		{
+			name:           "rolling without duration",
+			intervalType:   "rolling",
+			durationInDays: 0,
+			wantErr:        true,
+		},
+		{
+			name:         "fixed",
+			intervalType: "fixed",
+			startTime:    "2022-06-01T00:00:00Z",
+			endTime:      "2022-07-01T00:00:00Z",
+			want:         Window{Start: at(1, 0, 0), End: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:         "fixed with invalid start time",
+			intervalType: "fixed",
+			startTime:    "2022-06-01",
+			endTime:      "2022-07-01T00:00:00Z",
+			wantErr:      true,
+		},
+		{
+			name:         "fixed ending before it starts",
+			intervalType: "fixed",
+			startTime:    "2022-07-01T00:00:00Z",
+			endTime:      "2022-06-01T00:00:00Z",
+			wantErr:      true,
+		},
+		{
+			name:         "unknown interval type",
+			intervalType: "calendar",
+			wantErr:      true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := NewWindow(c.intervalType, c.durationInDays, c.startTime, c.endTime, now)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !got.Start.Equal(c.want.Start) || !got.End.Equal(c.want.End) {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	// 10 days at 99% gives an error budget of 144 minutes.
+	window := Window{Start: at(20, 12, 0), End: now}
+
+	cases := []struct {
+		name                        string
+		target                      float64
+		window                      Window
+		incidents                   []Incident
+		wantErrorBudget             time.Duration
+		wantRemainingErrorBudget    time.Duration
+		wantRemainingErrorBudgetPct float64
+		wantAttainment              float64
+		wantBurnRate                float64
+		wantViolatingIncidents      int
+	}{
+		{
+			name:                        "no incidents",
+			target:                      99,
+			window:                      window,
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    144 * time.Minute,
+			wantRemainingErrorBudgetPct: 100,
+			wantAttainment:              100,
+			wantBurnRate:                0,
+		},
+		{
+			name:   "resolved incident",
+			target: 99,
+			window: window,
+			incidents: []Incident{
+				{Start: at(25, 10, 0), End: at(25, 10, 36)},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    108 * time.Minute,
+			wantRemainingErrorBudgetPct: 75,
+			wantAttainment:              99.75,
+			wantBurnRate:                0.25,
+			wantViolatingIncidents:      1,
+		},
+		{
+			name:   "overlapping incidents are counted once",
+			target: 99,
+			window: window,
+			incidents: []Incident{
+				{Start: at(25, 10, 0), End: at(25, 10, 30)},
+				{Start: at(25, 10, 20), End: at(25, 10, 36)},
+				{Start: at(25, 10, 5), End: at(25, 10, 10)},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    108 * time.Minute,
+			wantRemainingErrorBudgetPct: 75,
+			wantAttainment:              99.75,
+			wantBurnRate:                0.25,
+			wantViolatingIncidents:      3,
+		},
+		{
+			name:   "false positives are ignored",
+			target: 99,
+			window: window,
+			incidents: []Incident{
+				{Start: at(25, 10, 0), End: at(25, 10, 36)},
+				{Start: at(26, 10, 0), End: at(26, 12, 0), FalsePositive: true},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    108 * time.Minute,
+			wantRemainingErrorBudgetPct: 75,
+			wantAttainment:              99.75,
+			wantBurnRate:                0.25,
+			wantViolatingIncidents:      1,
+		},
+		{
+			name:   "incidents are clipped to the window",
+			target: 99,
+			window: window,
+			incidents: []Incident{
+				{Start: at(10, 0, 0), End: at(11, 0, 0)},
+				{Start: at(20, 11, 0), End: at(20, 12, 36)},
+				// Ongoing incident.
+				{Start: at(30, 11, 24)},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    72 * time.Minute,
+			wantRemainingErrorBudgetPct: 50,
+			wantAttainment:              99.5,
+			wantBurnRate:                0.5,
+			wantViolatingIncidents:      2,
+		},
+		{
+			name:   "exhausted budget",
+			target: 99,
+			window: window,
+			incidents: []Incident{
+				{Start: at(25, 0, 0), End: at(25, 4, 48)},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    -144 * time.Minute,
+			wantRemainingErrorBudgetPct: -100,
+			wantAttainment:              98,
+			wantBurnRate:                2,
+			wantViolatingIncidents:      1,
+		},
+		{
+			name:   "fixed window which is half elapsed",
+			target: 99,
+			window: Window{Start: at(25, 12, 0), End: time.Date(2022, 7, 5, 12, 0, 0, 0, time.UTC)},
+			incidents: []Incident{
+				{Start: at(26, 0, 0), End: at(26, 0, 36)},
+			},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    108 * time.Minute,
+			wantRemainingErrorBudgetPct: 75,
+			wantAttainment:              99.5,
+			wantBurnRate:                0.5,
+			wantViolatingIncidents:      1,
+		},
+		{
+			name:                        "fixed window which did not start yet",
+			target:                      99,
+			window:                      Window{Start: time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2022, 7, 11, 0, 0, 0, 0, time.UTC)},
+			wantErrorBudget:             144 * time.Minute,
+			wantRemainingErrorBudget:    144 * time.Minute,
+			wantRemainingErrorBudgetPct: 100,
+			wantAttainment:              100,
+			wantBurnRate:                0,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Compute(c.target, c.window, c.incidents, now)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got.ErrorBudget != c.wantErrorBudget {
+				t.Errorf("error budget: expected %s, got %s", c.wantErrorBudget, got.ErrorBudget)
+			}
+			if got.RemainingErrorBudget != c.wantRemainingErrorBudget {
+				t.Errorf("remaining error budget: expected %s, got %s", c.wantRemainingErrorBudget, got.RemainingErrorBudget)
+			}
+			if !almostEqual(got.RemainingErrorBudgetPercent, c.wantRemainingErrorBudgetPct) {
+				t.Errorf("remaining error budget percent: expected %v, got %v", c.wantRemainingErrorBudgetPct, got.RemainingErrorBudgetPercent)
+			}
+			if !almostEqual(got.Attainment, c.wantAttainment) {
+				t.Errorf("attainment: expected %v, got %v", c.wantAttainment, got.Attainment)
+			}
+			if !almostEqual(got.BurnRate, c.wantBurnRate) {
+				t.Errorf("burn rate: expected %v, got %v", c.wantBurnRate, got.BurnRate)
+			}
+			if got.ViolatingIncidents != c.wantViolatingIncidents {
+				t.Errorf("violating incidents: expected %d, got %d", c.wantViolatingIncidents, got.ViolatingIncidents)
+			}
+		})
+	}
+}
+
+func TestComputeInvalidInput(t *testing.T) {
+	window := Window{Start: at(20, 12, 0), End: now}
+
+	for _, target := range []float64{0, 100, 120, -1} {
+		if _, err := Compute(target, window, nil, now); err == nil {
+			t.Errorf("expected an error for target %v", target)
+		}
+	}
+
+	if _, err := Compute(99, Window{Start: now, End: now}, nil, now); err == nil {
+		t.Error("expected an error for an empty window")
+	}
+}
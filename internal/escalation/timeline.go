@@ -0,0 +1,114 @@
+// Package escalation expands an escalation policy into the notifications it sends for an incident which is never acknowledged.
+package escalation
+
+import (
+	"sort"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+// ChannelPersonal is used when a rule has no notification channels, the targets are then notified according to their personal notification rules.
+const ChannelPersonal = "Personal"
+
+// Notification is a notification sent to a target of an escalation policy.
+type Notification struct {
+	// Minute is the number of minutes after the incident was triggered at which the notification is sent.
+	Minute int
+	// PolicyRepetition is 0 for the first run of the policy, and n for its n-th repetition.
+	PolicyRepetition int
+	// RuleIndex is the index of the rule which sends the notification.
+	RuleIndex int
+	// RuleRepetition is 0 for the first run of the rule, and n for its n-th repetition.
+	RuleRepetition int
+	TargetType     string
+	TargetID       string
+	Channel        string
+}
+
+// Timeline returns the notifications sent by the policy for an incident which is never acknowledged, ordered by minute.
+// Notifications sent at the same minute keep the order of the policy: repetition of the policy, rule, repetition of the rule, target and channel.
+//
+// The simulation follows these rules:
+//   - a rule starts delay_minutes after the previous rule started, the first rule starts delay_minutes after the incident was triggered.
+//   - a repeated rule notifies its targets again every repeat delay_minutes, `times` more times.
+//   - a round robin rule notifies a single target, the timeline assumes that the round robin is at its first target.
+//     The target is notified again when the rule is repeated.
+//   - a round robin rule with rotation notifies the next target every rotation delay_minutes, until every target has been notified once.
+//   - a repeated policy runs again repeat delay_minutes after the last notification of the previous run, `times` more times.
+func Timeline(policy *api.EscalationPolicy) []*Notification {
+	notifications := make([]*Notification, 0)
+
+	start := 0
+	for p := 0; p <= policy.RepeatTimes; p++ {
+		run := timelineRun(policy.Rules, p, start)
+		if len(run) == 0 {
+			break
+		}
+		notifications = append(notifications, run...)
+
+		last := 0
+		for _, n := range run {
+			if n.Minute > last {
+				last = n.Minute
+			}
+		}
+		start = last + policy.RepeatAfterMinutes
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].Minute < notifications[j].Minute
+	})
+
+	return notifications
+}
+
+// timelineRun returns the notifications of a single run of the rules, starting at the given minute.
+func timelineRun(rules []*api.EscalationPolicyRule, policyRepetition int, start int) []*Notification {
+	notifications := make([]*Notification, 0)
+
+	ruleStart := start
+	for i, rule := range rules {
+		ruleStart += rule.EscalateAfterMinutes
+
+		notify := func(minute, ruleRepetition int, target *api.EscalationPolicyTarget) {
+			channels := rule.Via
+			if len(channels) == 0 {
+				channels = []string{ChannelPersonal}
+			}
+			for _, channel := range channels {
+				notifications = append(notifications, &Notification{
+					Minute:           minute,
+					PolicyRepetition: policyRepetition,
+					RuleIndex:        i,
+					RuleRepetition:   ruleRepetition,
+					TargetType:       target.Type,
+					TargetID:         target.ID,
+					Channel:          channel,
+				})
+			}
+		}
+
+		if len(rule.Targets) == 0 {
+			continue
+		}
+
+		if rule.RoundrobinEnabled && rule.EscalateWithinRoundrobin {
+			for t, target := range rule.Targets {
+				notify(ruleStart+t*rule.RepeatAfterMinutes, 0, target)
+			}
+			continue
+		}
+
+		targets := rule.Targets
+		if rule.RoundrobinEnabled {
+			targets = targets[:1]
+		}
+		for r := 0; r <= rule.RepeatTimes; r++ {
+			for _, target := range targets {
+				notify(ruleStart+r*rule.RepeatAfterMinutes, r, target)
+			}
+		}
+	}
+
+	return notifications
+}
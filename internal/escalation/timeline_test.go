@@ -0,0 +1,341 @@
+package escalation
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+var (
+	alice    = &api.EscalationPolicyTarget{ID: "alice", Type: "user"}
+	bob      = &api.EscalationPolicyTarget{ID: "bob", Type: "user"}
+	carol    = &api.EscalationPolicyTarget{ID: "carol", Type: "user"}
+	sre      = &api.EscalationPolicyTarget{ID: "sre", Type: "squad"}
+	primary  = &api.EscalationPolicyTarget{ID: "primary", Type: "schedule"}
+	allUsers = []*api.EscalationPolicyTarget{alice, bob, carol}
+)
+
+// format renders a notification as "minute policy-repetition/rule/rule-repetition type:id channel".
+func format(notifications []*Notification) []string {
+	lines := make([]string, len(notifications))
+	for i, n := range notifications {
+		lines[i] = fmt.Sprintf("%d %d/%d/%d %s:%s %s", n.Minute, n.PolicyRepetition, n.RuleIndex, n.RuleRepetition, n.TargetType, n.TargetID, n.Channel)
+	}
+	return lines
+}
+
+func TestTimeline(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy *api.EscalationPolicy
+		want   []string
+	}{
+		{
+			name:   "no rules",
+			policy: &api.EscalationPolicy{},
+			want:   []string{},
+		},
+		{
+			name: "rule without targets",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0, Via: []string{"Email"}},
+				},
+			},
+			want: []string{},
+		},
+		{
+			name: "single rule without channels",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0, Targets: []*api.EscalationPolicyTarget{alice}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Personal",
+			},
+		},
+		{
+			name: "single rule with channels and targets of every type",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 5,
+						Via:                  []string{"SMS", "Email"},
+						Targets:              []*api.EscalationPolicyTarget{alice, sre, primary},
+					},
+				},
+			},
+			want: []string{
+				"5 0/0/0 user:alice SMS",
+				"5 0/0/0 user:alice Email",
+				"5 0/0/0 squad:sre SMS",
+				"5 0/0/0 squad:sre Email",
+				"5 0/0/0 schedule:primary SMS",
+				"5 0/0/0 schedule:primary Email",
+			},
+		},
+		{
+			name: "rules start after the previous rule",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0, Via: []string{"Push"}, Targets: []*api.EscalationPolicyTarget{alice}},
+					{EscalateAfterMinutes: 10, Via: []string{"Phone"}, Targets: []*api.EscalationPolicyTarget{bob}},
+					{EscalateAfterMinutes: 15, Via: []string{"Phone"}, Targets: []*api.EscalationPolicyTarget{sre}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Push",
+				"10 0/1/0 user:bob Phone",
+				"25 0/2/0 squad:sre Phone",
+			},
+		},
+		{
+			name: "repeated rule",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 0,
+						Via:                  []string{"Email"},
+						Targets:              []*api.EscalationPolicyTarget{alice, bob},
+						RepeatTimes:          2,
+						RepeatAfterMinutes:   5,
+					},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Email",
+				"0 0/0/0 user:bob Email",
+				"5 0/0/1 user:alice Email",
+				"5 0/0/1 user:bob Email",
+				"10 0/0/2 user:alice Email",
+				"10 0/0/2 user:bob Email",
+			},
+		},
+		{
+			name: "repetitions of a rule interleave with the next rule",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 0,
+						Via:                  []string{"Push"},
+						Targets:              []*api.EscalationPolicyTarget{alice},
+						RepeatTimes:          2,
+						RepeatAfterMinutes:   10,
+					},
+					{EscalateAfterMinutes: 15, Via: []string{"Push"}, Targets: []*api.EscalationPolicyTarget{bob}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Push",
+				"10 0/0/1 user:alice Push",
+				"15 0/1/0 user:bob Push",
+				"20 0/0/2 user:alice Push",
+			},
+		},
+		{
+			name: "notifications at the same minute keep the order of the policy",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 0,
+						Via:                  []string{"Push"},
+						Targets:              []*api.EscalationPolicyTarget{alice},
+						RepeatTimes:          1,
+						RepeatAfterMinutes:   10,
+					},
+					{EscalateAfterMinutes: 10, Via: []string{"Push"}, Targets: []*api.EscalationPolicyTarget{bob}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Push",
+				"10 0/0/1 user:alice Push",
+				"10 0/1/0 user:bob Push",
+			},
+		},
+		{
+			name: "round robin notifies the first target",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 0,
+						Via:                  []string{"SMS"},
+						Targets:              allUsers,
+						RoundrobinEnabled:    true,
+					},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice SMS",
+			},
+		},
+		{
+			name: "repeated round robin notifies the first target again",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 0,
+						Via:                  []string{"SMS"},
+						Targets:              allUsers,
+						RoundrobinEnabled:    true,
+						RepeatTimes:          1,
+						RepeatAfterMinutes:   3,
+					},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice SMS",
+				"3 0/0/1 user:alice SMS",
+			},
+		},
+		{
+			name: "round robin with rotation notifies every target once",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes:     2,
+						Via:                      []string{"Phone"},
+						Targets:                  allUsers,
+						RoundrobinEnabled:        true,
+						EscalateWithinRoundrobin: true,
+						RepeatAfterMinutes:       5,
+					},
+				},
+			},
+			want: []string{
+				"2 0/0/0 user:alice Phone",
+				"7 0/0/0 user:bob Phone",
+				"12 0/0/0 user:carol Phone",
+			},
+		},
+		{
+			name: "rotation without round robin is ignored",
+			policy: &api.EscalationPolicy{
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes:     0,
+						Via:                      []string{"Phone"},
+						Targets:                  []*api.EscalationPolicyTarget{alice, bob},
+						EscalateWithinRoundrobin: true,
+						RepeatAfterMinutes:       5,
+					},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Phone",
+				"0 0/0/0 user:bob Phone",
+			},
+		},
+		{
+			name: "repeated policy starts after the last notification",
+			policy: &api.EscalationPolicy{
+				RepeatTimes:        2,
+				RepeatAfterMinutes: 20,
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0, Via: []string{"Email"}, Targets: []*api.EscalationPolicyTarget{alice}},
+					{EscalateAfterMinutes: 10, Via: []string{"Email"}, Targets: []*api.EscalationPolicyTarget{bob}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 user:alice Email",
+				"10 0/1/0 user:bob Email",
+				"30 1/0/0 user:alice Email",
+				"40 1/1/0 user:bob Email",
+				"60 2/0/0 user:alice Email",
+				"70 2/1/0 user:bob Email",
+			},
+		},
+		{
+			name: "repeated policy waits for repetitions and rotations of the previous run",
+			policy: &api.EscalationPolicy{
+				RepeatTimes:        1,
+				RepeatAfterMinutes: 10,
+				Rules: []*api.EscalationPolicyRule{
+					{
+						EscalateAfterMinutes: 5,
+						Via:                  []string{"Push"},
+						Targets:              []*api.EscalationPolicyTarget{sre},
+						RepeatTimes:          1,
+						RepeatAfterMinutes:   30,
+					},
+					{
+						EscalateAfterMinutes:     5,
+						Via:                      []string{"Phone"},
+						Targets:                  []*api.EscalationPolicyTarget{alice, bob},
+						RoundrobinEnabled:        true,
+						EscalateWithinRoundrobin: true,
+						RepeatAfterMinutes:       10,
+					},
+				},
+			},
+			want: []string{
+				"5 0/0/0 squad:sre Push",
+				"10 0/1/0 user:alice Phone",
+				"20 0/1/0 user:bob Phone",
+				"35 0/0/1 squad:sre Push",
+				"50 1/0/0 squad:sre Push",
+				"55 1/1/0 user:alice Phone",
+				"65 1/1/0 user:bob Phone",
+				"80 1/0/1 squad:sre Push",
+			},
+		},
+		{
+			name: "repeated policy without delay",
+			policy: &api.EscalationPolicy{
+				RepeatTimes: 1,
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0, Targets: []*api.EscalationPolicyTarget{primary}},
+				},
+			},
+			want: []string{
+				"0 0/0/0 schedule:primary Personal",
+				"0 1/0/0 schedule:primary Personal",
+			},
+		},
+		{
+			name: "repeated policy without targets",
+			policy: &api.EscalationPolicy{
+				RepeatTimes:        3,
+				RepeatAfterMinutes: 10,
+				Rules: []*api.EscalationPolicyRule{
+					{EscalateAfterMinutes: 0},
+				},
+			},
+			want: []string{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := format(Timeline(c.policy))
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("expected:\n%v\ngot:\n%v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestTimelineIsSortedByMinute(t *testing.T) {
+	policy := &api.EscalationPolicy{
+		RepeatTimes:        3,
+		RepeatAfterMinutes: 1,
+		Rules: []*api.EscalationPolicyRule{
+			{EscalateAfterMinutes: 0, Via: []string{"Email", "SMS"}, Targets: allUsers, RepeatTimes: 3, RepeatAfterMinutes: 7},
+			{EscalateAfterMinutes: 1, Via: []string{"Phone"}, Targets: allUsers, RoundrobinEnabled: true, EscalateWithinRoundrobin: true, RepeatAfterMinutes: 4},
+			{EscalateAfterMinutes: 2, Targets: []*api.EscalationPolicyTarget{sre}, RoundrobinEnabled: true, RepeatTimes: 2, RepeatAfterMinutes: 1},
+		},
+	}
+
+	notifications := Timeline(policy)
+	// (4 runs of the first rule * 3 targets * 2 channels + 3 rotations + 3 runs of the round robin) * 4 runs of the policy
+	if len(notifications) != (4*3*2+3+3)*4 {
+		t.Fatalf("unexpected number of notifications: %d", len(notifications))
+	}
+	for i := 1; i < len(notifications); i++ {
+		if notifications[i].Minute < notifications[i-1].Minute {
+			t.Fatalf("notification %d (minute %d) is before notification %d (minute %d)", i, notifications[i].Minute, i-1, notifications[i-1].Minute)
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Package evaluation evaluates the rules of a service against sample alert payloads, without calling the Squadcast API.
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tags"
+)
+
+// Rules are the rules of a service.
+type Rules struct {
+	Deduplication []*api.DeduplicationRule
+	Suppression   []*api.SuppressionRule
+	Routing       []*api.RoutingRule
+	Tagging       []*api.TaggingRule
+}
+
+// Result is the outcome of the rules for a payload. Indexes of rules and payloads are -1 when nothing matched.
+type Result struct {
+	// Suppressed is true when a suppression rule matched, SuppressionRule is the index of the first one.
+	Suppressed      bool
+	SuppressionRule int
+
+	// Deduplicated is true when a deduplication rule matched the payload and the payload of an open incident,
+	// DeduplicatedWith is the index of that payload.
+	Deduplicated      bool
+	DeduplicationRule int
+	DeduplicatedWith  int
+
+	// RoutingRule is the index of the first routing rule which matched, RouteTo is its target.
+	RoutingRule int
+	RouteTo     *api.RouteTo
+
+	// TaggingRules are the indexes of the tagging rules which matched, Tags the tags they added, with the fields of the payload interpolated in their values.
+	// When several rules add the same key, the last rule wins.
+	TaggingRules []int
+	Tags         map[string]api.TaggingRuleTagValue
+}
+
+type rule struct {
+	name string
+	node expression.Node
+}
+
+// match evaluates the rule, a rule with an empty expression never matches.
+func (r *rule) match(env expression.Env) (bool, error) {
+	if r.node == nil {
+		return false, nil
+	}
+	v, err := expression.Eval(r.node, env)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", r.name, err)
+	}
+	return expression.Truthy(v), nil
+}
+
+func compile[T api.RuleCondition](kind string, index int, isBasic bool, expr string, conditions []T) (*rule, error) {
+	name := fmt.Sprintf("%s rule %d", kind, index)
+
+	compiled, err := api.RuleExpression(isBasic, expr, conditions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	r := &rule{name: name}
+	if compiled == "" {
+		return r, nil
+	}
+
+	r.node, err = expression.Parse(compiled)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return r, nil
+}
+
+// Evaluate evaluates the rules against each payload, in order, as if the payloads were alerts received by the service one after the other.
+//
+// A payload is suppressed when any suppression rule matches it, suppressed payloads never open an incident.
+// Otherwise, a payload is deduplicated when a deduplication rule matches it and the payload of an open incident,
+// i.e. an earlier payload which was neither suppressed nor deduplicated, assuming all payloads are received within the time window of the rule.
+// Deduplication rules are tried in order, against the most recent incident first.
+// Routing and tagging rules are evaluated for every payload.
+func Evaluate(rules *Rules, payloads []any) ([]*Result, error) {
+	suppression := make([]*rule, len(rules.Suppression))
+	for i, r := range rules.Suppression {
+		compiled, err := compile("suppression", i, r.IsBasic, r.Expression, r.BasicExpression)
+		if err != nil {
+			return nil, err
+		}
+		suppression[i] = compiled
+	}
+
+	deduplication := make([]*rule, len(rules.Deduplication))
+	for i, r := range rules.Deduplication {
+		compiled, err := compile("deduplication", i, r.IsBasic, r.Expression, r.BasicExpression)
+		if err != nil {
+			return nil, err
+		}
+		deduplication[i] = compiled
+	}
+
+	routing := make([]*rule, len(rules.Routing))
+	for i, r := range rules.Routing {
+		compiled, err := compile("routing", i, r.IsBasic, r.Expression, r.BasicExpression)
+		if err != nil {
+			return nil, err
+		}
+		routing[i] = compiled
+	}
+
+	tagging := make([]*rule, len(rules.Tagging))
+	templates := make([]map[string]*tags.Template, len(rules.Tagging))
+	for i, r := range rules.Tagging {
+		compiled, err := compile("tagging", i, r.IsBasic, r.Expression, r.BasicExpression)
+		if err != nil {
+			return nil, err
+		}
+		tagging[i] = compiled
+
+		templates[i] = make(map[string]*tags.Template, len(r.Tags))
+		for key, value := range r.Tags {
+			templates[i][key], err = tags.ParseTemplate(value.Value)
+			if err != nil {
+				return nil, fmt.Errorf("tagging rule %d: tag %q: %w", i, key, err)
+			}
+		}
+	}
+
+	results := make([]*Result, len(payloads))
+	incidents := make([]int, 0)
+	for i, payload := range payloads {
+		result := &Result{
+			SuppressionRule:   -1,
+			DeduplicationRule: -1,
+			DeduplicatedWith:  -1,
+			RoutingRule:       -1,
+			TaggingRules:      []int{},
+			Tags:              map[string]api.TaggingRuleTagValue{},
+		}
+		results[i] = result
+		env := expression.Env{"payload": payload}
+
+		for j, r := range suppression {
+			ok, err := r.match(env)
+			if err != nil {
+				return nil, fmt.Errorf("payload %d: %w", i, err)
+			}
+			if ok {
+				result.Suppressed = true
+				result.SuppressionRule = j
+				break
+			}
+		}
+
+		if !result.Suppressed {
+		deduplicate:
+			for j, r := range deduplication {
+				for k := len(incidents) - 1; k >= 0; k-- {
+					past := payloads[incidents[k]]
+					ok, err := r.match(expression.Env{"payload": payload, "current": map[string]any{"payload": payload}, "past": map[string]any{"payload": past}})
+					if err != nil {
+						return nil, fmt.Errorf("payload %d: %w", i, err)
+					}
+					if ok {
+						result.Deduplicated = true
+						result.DeduplicationRule = j
+						result.DeduplicatedWith = incidents[k]
+						break deduplicate
+					}
+				}
+			}
+			if !result.Deduplicated {
+				incidents = append(incidents, i)
+			}
+		}
+
+		for j, r := range routing {
+			ok, err := r.match(env)
+			if err != nil {
+				return nil, fmt.Errorf("payload %d: %w", i, err)
+			}
+			if ok {
+				result.RoutingRule = j
+				result.RouteTo = &rules.Routing[j].RouteTo
+				break
+			}
+		}
+
+		for j, r := range tagging {
+			ok, err := r.match(env)
+			if err != nil {
+				return nil, fmt.Errorf("payload %d: %w", i, err)
+			}
+			if ok {
+				result.TaggingRules = append(result.TaggingRules, j)
+				for key, value := range rules.Tagging[j].Tags {
+					rendered, err := templates[j][key].Render(payload)
+					if err != nil {
+						return nil, fmt.Errorf("payload %d: tagging rule %d: tag %q: %w", i, j, key, err)
+					}
+					result.Tags[key] = api.TaggingRuleTagValue{Value: rendered, Color: value.Color}
+				}
+			}
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,198 @@
+package evaluation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func payloads(t *testing.T, docs ...string) []any {
+	t.Helper()
+	payloads := make([]any, len(docs))
+	for i, doc := range docs {
+		if err := json.Unmarshal([]byte(doc), &payloads[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return payloads
+}
+
+func TestEvaluate(t *testing.T) {
+	rules := &Rules{
+		Suppression: []*api.SuppressionRule{
+			{Expression: `payload.env == "staging"`},
+			{IsBasic: true, BasicExpression: []*api.SuppressionRuleCondition{{LHS: `payload.message`, Op: "contains", RHS: "test"}}},
+		},
+		Deduplication: []*api.DeduplicationRule{
+			{Expression: `past.payload.host == current.payload.host && payload.severity == "low"`},
+			{IsBasic: true, BasicExpression: []*api.DeduplicationRuleCondition{{LHS: `payload.alertname`, Op: "is", RHS: "DiskFull"}}},
+		},
+		Routing: []*api.RoutingRule{
+			{Expression: `payload.severity == "critical"`, RouteTo: api.RouteTo{EntityID: "pager", EntityType: "escalationpolicy"}},
+			{IsBasic: true, BasicExpression: []*api.RoutingRuleCondition{{LHS: `payload.team`, RHS: "db"}}, RouteTo: api.RouteTo{EntityID: "dba", EntityType: "squad"}},
+			{Expression: ``, RouteTo: api.RouteTo{EntityID: "never", EntityType: "user"}},
+		},
+		Tagging: []*api.TaggingRule{
+			{Expression: `payload.host =~ "^db-"`, Tags: map[string]api.TaggingRuleTagValue{"tier": {Value: "database", Color: "#ababab"}}},
+			{Expression: `payload.severity in ["critical", "high"]`, Tags: map[string]api.TaggingRuleTagValue{"tier": {Value: "urgent", Color: "#ff0000"}, "page": {Value: "yes", Color: "#000000"}}},
+		},
+	}
+
+	results, err := Evaluate(rules, payloads(t,
+		`{"host": "db-1", "severity": "critical", "team": "db"}`,
+		`{"host": "db-1", "severity": "low", "team": "db"}`,
+		`{"host": "web-1", "severity": "low", "env": "staging"}`,
+		`{"host": "web-1", "severity": "low", "message": "a test alert"}`,
+		`{"host": "web-2", "severity": "high", "alertname": "DiskFull"}`,
+		`{"host": "web-3", "severity": "high", "alertname": "DiskFull"}`,
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	routeToPager := &rules.Routing[0].RouteTo
+	routeToDBA := &rules.Routing[1].RouteTo
+	want := []*Result{
+		{
+			SuppressionRule: -1, DeduplicationRule: -1, DeduplicatedWith: -1,
+			RoutingRule: 0, RouteTo: routeToPager,
+			TaggingRules: []int{0, 1},
+			Tags:         map[string]api.TaggingRuleTagValue{"tier": {Value: "urgent", Color: "#ff0000"}, "page": {Value: "yes", Color: "#000000"}},
+		},
+		{
+			SuppressionRule: -1, Deduplicated: true, DeduplicationRule: 0, DeduplicatedWith: 0,
+			RoutingRule: 1, RouteTo: routeToDBA,
+			TaggingRules: []int{0},
+			Tags:         map[string]api.TaggingRuleTagValue{"tier": {Value: "database", Color: "#ababab"}},
+		},
+		{
+			Suppressed: true, SuppressionRule: 0, DeduplicationRule: -1, DeduplicatedWith: -1,
+			RoutingRule:  -1,
+			TaggingRules: []int{},
+			Tags:         map[string]api.TaggingRuleTagValue{},
+		},
+		{
+			Suppressed: true, SuppressionRule: 1, DeduplicationRule: -1, DeduplicatedWith: -1,
+			RoutingRule:  -1,
+			TaggingRules: []int{},
+			Tags:         map[string]api.TaggingRuleTagValue{},
+		},
+		{
+			SuppressionRule: -1, Deduplicated: true, DeduplicationRule: 1, DeduplicatedWith: 0,
+			RoutingRule:  -1,
+			TaggingRules: []int{1},
+			Tags:         map[string]api.TaggingRuleTagValue{"tier": {Value: "urgent", Color: "#ff0000"}, "page": {Value: "yes", Color: "#000000"}},
+		},
+		{
+			SuppressionRule: -1, Deduplicated: true, DeduplicationRule: 1, DeduplicatedWith: 0,
+			RoutingRule:  -1,
+			TaggingRules: []int{1},
+			Tags:         map[string]api.TaggingRuleTagValue{"tier": {Value: "urgent", Color: "#ff0000"}, "page": {Value: "yes", Color: "#000000"}},
+		},
+	}
+
+	for i := range want {
+		if !reflect.DeepEqual(results[i], want[i]) {
+			t.Errorf("payload %d: expected %+v, got %+v", i, want[i], results[i])
+		}
+	}
+}
+
+func TestEvaluateDeduplicatesWithTheMostRecentIncident(t *testing.T) {
+	rules := &Rules{
+		Deduplication: []*api.DeduplicationRule{
+			{Expression: `past.payload.host == current.payload.host`},
+		},
+	}
+
+	results, err := Evaluate(rules, payloads(t,
+		`{"host": "a", "n": 0}`,
+		`{"host": "b", "n": 1}`,
+		`{"host": "a", "n": 2}`,
+		`{"host": "c", "n": 3}`,
+		`{"host": "b", "n": 4}`,
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make([]int, len(results))
+	for i, result := range results {
+		got[i] = result.DeduplicatedWith
+	}
+	if want := []int{-1, -1, 0, -1, 1}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func TestEvaluateTagTemplates(t *testing.T) {
+	rules := &Rules{
+		Tagging: []*api.TaggingRule{
+			{Expression: `payload.labels`, Tags: map[string]api.TaggingRuleTagValue{
+				"host":    {Value: `{{ payload.labels.instance }}`, Color: "#ababab"},
+				"cluster": {Value: `k8s-{{ payload.labels.cluster | default "none" }}`, Color: "#000000"},
+			}},
+		},
+	}
+
+	results, err := Evaluate(rules, payloads(t,
+		`{"labels": {"instance": "db-1", "cluster": "eu"}}`,
+		`{"labels": {"instance": "db-2"}}`,
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []map[string]api.TaggingRuleTagValue{
+		{"host": {Value: "db-1", Color: "#ababab"}, "cluster": {Value: "k8s-eu", Color: "#000000"}},
+		{"host": {Value: "db-2", Color: "#ababab"}, "cluster": {Value: "k8s-none", Color: "#000000"}},
+	}
+	for i := range want {
+		if !reflect.DeepEqual(results[i].Tags, want[i]) {
+			t.Errorf("payload %d: expected %+v, got %+v", i, want[i], results[i].Tags)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		rules *Rules
+		want  string
+	}{
+		{
+			name: "invalid basic expression",
+			rules: &Rules{Tagging: []*api.TaggingRule{
+				{},
+				{IsBasic: true, BasicExpression: []*api.TaggingRuleCondition{{LHS: `payload.a`, Op: "equals", RHS: "1"}}},
+			}},
+			want: "tagging rule 1: condition 0: unknown op \"equals\", expected one of `is`, `is_not`, `contains`, `not_contains`, `starts_with`, `ends_with`, `matches`, `not_matches`",
+		},
+		{
+			name:  "invalid tag template",
+			rules: &Rules{Tagging: []*api.TaggingRule{{Tags: map[string]api.TaggingRuleTagValue{"host": {Value: "{{ payload.host"}}}}},
+			want:  "tagging rule 0: tag \"host\": column 1: unclosed `{{`, expected `}}`",
+		},
+		{
+			name:  "invalid expression",
+			rules: &Rules{Routing: []*api.RoutingRule{{Expression: `payload.a = 1`}}},
+			want:  "routing rule 0: column 11: unexpected character \"=\", did you mean \"==\"?",
+		},
+		{
+			name:  "evaluation error",
+			rules: &Rules{Suppression: []*api.SuppressionRule{{Expression: `payload.a / 0 == 1`}}},
+			want:  "payload 0: suppression rule 0: column 11: division by zero",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Evaluate(c.rules, payloads(t, `{"a": 1}`))
+			if err == nil || err.Error() != c.want {
+				t.Fatalf("expected %q, got %v", c.want, err)
+			}
+		})
+	}
+}
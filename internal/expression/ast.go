@@ -0,0 +1,134 @@
+package expression
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Node is a node of the syntax tree of an expression.
+type Node interface {
+	// Column is the 1-based column of the node in the source of the expression.
+	Column() int
+	// String returns the node in the canonical syntax of rule expressions.
+	String() string
+}
+
+type position int
+
+func (p position) Column() int {
+	return int(p)
+}
+
+// Literal is a string, number (float64), boolean or null value.
+type Literal struct {
+	position
+	Value any
+}
+
+func (n *Literal) String() string {
+	switch v := n.Value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return quote(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+// Ident is a reference to a root object of the expression, e.g. `payload`.
+type Ident struct {
+	position
+	Name string
+}
+
+func (n *Ident) String() string {
+	return n.Name
+}
+
+// Member is a field access with a dot, e.g. `payload.labels`.
+type Member struct {
+	position
+	X     Node
+	Field string
+}
+
+func (n *Member) String() string {
+	return n.X.String() + "." + n.Field
+}
+
+// Index is a field or element access with brackets, e.g. `payload["labels"]` or `payload.tags[0]`.
+type Index struct {
+	position
+	X     Node
+	Index Node
+}
+
+func (n *Index) String() string {
+	return n.X.String() + "[" + n.Index.String() + "]"
+}
+
+// Call is a call to one of the Functions, e.g. `re(payload.message, "timeout")`.
+type Call struct {
+	position
+	Func string
+	Args []Node
+}
+
+func (n *Call) String() string {
+	args := make([]string, len(n.Args))
+	for i, arg := range n.Args {
+		args[i] = arg.String()
+	}
+	return n.Func + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Array is a list of values, e.g. `["critical", "high"]`.
+type Array struct {
+	position
+	Elems []Node
+}
+
+func (n *Array) String() string {
+	elems := make([]string, len(n.Elems))
+	for i, elem := range n.Elems {
+		elems[i] = elem.String()
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+// Unary is `!x` or `-x`.
+type Unary struct {
+	position
+	Op string
+	X  Node
+}
+
+func (n *Unary) String() string {
+	return n.Op + wrap(n.X, precedenceUnary)
+}
+
+// Binary is a binary operation, e.g. `x == y` or `x && y`.
+type Binary struct {
+	position
+	Op string
+	X  Node
+	Y  Node
+}
+
+func (n *Binary) String() string {
+	p := precedence[n.Op]
+	// Binary operators are left associative, the right operand needs parentheses at the same precedence.
+	return wrap(n.X, p) + " " + n.Op + " " + wrap(n.Y, p+1)
+}
+
+// wrap returns the node, in parentheses when its precedence is lower than the given precedence.
+func wrap(n Node, p int) string {
+	if b, ok := n.(*Binary); ok && precedence[b.Op] < p {
+		return "(" + b.String() + ")"
+	}
+	return n.String()
+}
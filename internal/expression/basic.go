@@ -0,0 +1,171 @@
+package expression
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operators of the conditions of basic expressions.
+const (
+	OpIs          = "is"
+	OpIsNot       = "is_not"
+	OpContains    = "contains"
+	OpNotContains = "not_contains"
+	OpStartsWith  = "starts_with"
+	OpEndsWith    = "ends_with"
+	OpMatches     = "matches"
+	OpNotMatches  = "not_matches"
+)
+
+// Ops are the operators of the conditions of basic expressions.
+var Ops = []string{OpIs, OpIsNot, OpContains, OpNotContains, OpStartsWith, OpEndsWith, OpMatches, OpNotMatches}
+
+// Condition is a condition of a basic expression, e.g. `payload["source"]` is `prometheus`.
+// A basic expression matches when all of its conditions match.
+type Condition struct {
+	// LHS is the path of a field of the payload, e.g. `payload["source"]` or `payload.labels.severity`.
+	LHS string
+	// Op is one of Ops, an empty operator is OpIs.
+	Op string
+	// RHS is the value the field is compared with.
+	RHS string
+}
+
+var binaryOps = map[string]string{
+	OpIs:         "==",
+	OpIsNot:      "!=",
+	OpMatches:    "=~",
+	OpNotMatches: "!~",
+}
+
+var callOps = map[string]string{
+	OpContains:    "contains",
+	OpNotContains: "contains",
+	OpStartsWith:  "startsWith",
+	OpEndsWith:    "endsWith",
+}
+
+// Compile returns the expression which is equivalent to the conditions of a basic expression.
+// An empty list of conditions compiles to an empty expression.
+func Compile(conditions []Condition) (string, error) {
+	terms := make([]string, len(conditions))
+	for i, c := range conditions {
+		lhs, err := Parse(c.LHS)
+		if err != nil {
+			return "", fmt.Errorf("condition %d: invalid lhs %s: %w", i, quote(c.LHS), err)
+		}
+		if !IsPath(lhs) {
+			return "", fmt.Errorf("condition %d: lhs must be the path of a field, e.g. `payload[\"source\"]`, got %s", i, quote(c.LHS))
+		}
+		rhs := &Literal{Value: c.RHS}
+
+		op := c.Op
+		if op == "" {
+			op = OpIs
+		}
+
+		var term Node
+		if binaryOp, ok := binaryOps[op]; ok {
+			term = &Binary{Op: binaryOp, X: lhs, Y: rhs}
+		} else if f, ok := callOps[op]; ok {
+			term = &Call{Func: f, Args: []Node{lhs, rhs}}
+			if op == OpNotContains {
+				term = &Unary{Op: "!", X: term}
+			}
+		} else {
+			return "", fmt.Errorf("condition %d: unknown op %s, expected one of %s", i, quote(c.Op), list(Ops))
+		}
+		terms[i] = term.String()
+	}
+
+	return strings.Join(terms, " && "), nil
+}
+
+// Decompile returns the conditions of the basic expression which is equivalent to the expression.
+// It returns false when the expression cannot be written as a basic expression, e.g. when it uses `||`.
+func Decompile(src string) ([]Condition, bool) {
+	if strings.TrimSpace(src) == "" {
+		return []Condition{}, true
+	}
+
+	node, err := Parse(src)
+	if err != nil {
+		return nil, false
+	}
+
+	terms := conjunction(node)
+	conditions := make([]Condition, len(terms))
+	for i, term := range terms {
+		c, ok := decompileTerm(term)
+		if !ok {
+			return nil, false
+		}
+		conditions[i] = c
+	}
+
+	return conditions, true
+}
+
+// conjunction returns the terms of the node which are combined with `&&`.
+func conjunction(node Node) []Node {
+	if b, ok := node.(*Binary); ok && b.Op == "&&" {
+		return append(conjunction(b.X), conjunction(b.Y)...)
+	}
+	return []Node{node}
+}
+
+func decompileTerm(term Node) (Condition, bool) {
+	switch n := term.(type) {
+	case *Binary:
+		for op, binaryOp := range binaryOps {
+			if n.Op == binaryOp {
+				return condition(n.X, op, n.Y)
+			}
+		}
+
+	case *Call:
+		for op, f := range callOps {
+			if n.Func == f && op != OpNotContains && len(n.Args) == 2 {
+				return condition(n.Args[0], op, n.Args[1])
+			}
+		}
+
+	case *Unary:
+		if call, ok := n.X.(*Call); ok && n.Op == "!" && call.Func == callOps[OpNotContains] && len(call.Args) == 2 {
+			return condition(call.Args[0], OpNotContains, call.Args[1])
+		}
+	}
+
+	return Condition{}, false
+}
+
+func condition(lhs Node, op string, rhs Node) (Condition, bool) {
+	literal, ok := rhs.(*Literal)
+	if !ok || !IsPath(lhs) {
+		return Condition{}, false
+	}
+	value, ok := literal.Value.(string)
+	if !ok {
+		return Condition{}, false
+	}
+	return Condition{LHS: lhs.String(), Op: op, RHS: value}, true
+}
+
+// IsPath returns true if the node is a field of a root, e.g. `payload.labels["severity"]`.
+func IsPath(node Node) bool {
+	for {
+		switch n := node.(type) {
+		case *Ident:
+			return true
+		case *Member:
+			node = n.X
+		case *Index:
+			if _, ok := n.Index.(*Literal); !ok {
+				return false
+			}
+			node = n.X
+		default:
+			return false
+		}
+	}
+}
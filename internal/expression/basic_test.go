@@ -0,0 +1,130 @@
+package expression
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	cases := []struct {
+		name       string
+		conditions []Condition
+		want       string
+		wantErr    string
+	}{
+		{name: "no conditions", conditions: []Condition{}, want: ""},
+		{
+			name:       "condition without op",
+			conditions: []Condition{{LHS: `payload["foo"]`, RHS: "bar"}},
+			want:       `payload["foo"] == "bar"`,
+		},
+		{
+			name: "every op",
+			conditions: []Condition{
+				{LHS: `payload.a`, Op: OpIs, RHS: "1"},
+				{LHS: `payload.b`, Op: OpIsNot, RHS: "2"},
+				{LHS: `payload.c`, Op: OpContains, RHS: "3"},
+				{LHS: `payload.d`, Op: OpNotContains, RHS: "4"},
+				{LHS: `payload.e`, Op: OpStartsWith, RHS: "5"},
+				{LHS: `payload.f`, Op: OpEndsWith, RHS: "6"},
+				{LHS: `payload.g`, Op: OpMatches, RHS: `^web-\d+$`},
+				{LHS: `payload.h`, Op: OpNotMatches, RHS: "8"},
+			},
+			want: `payload.a == "1" && payload.b != "2" && contains(payload.c, "3") && !contains(payload.d, "4") && ` +
+				`startsWith(payload.e, "5") && endsWith(payload.f, "6") && payload.g =~ "^web-\\d+$" && payload.h !~ "8"`,
+		},
+		{
+			name:       "values are quoted",
+			conditions: []Condition{{LHS: `payload.message`, Op: OpIs, RHS: `say "hi"`}},
+			want:       `payload.message == "say \"hi\""`,
+		},
+		{
+			name:       "unknown op",
+			conditions: []Condition{{LHS: `payload.a`, Op: "equals", RHS: "1"}},
+			wantErr:    "condition 0: unknown op \"equals\", expected one of `is`, `is_not`, `contains`, `not_contains`, `starts_with`, `ends_with`, `matches`, `not_matches`",
+		},
+		{
+			name:       "invalid lhs",
+			conditions: []Condition{{LHS: `payload.a`, RHS: "1"}, {LHS: `payload[`, RHS: "1"}},
+			wantErr:    "condition 1: invalid lhs \"payload[\": column 9: unexpected end of expression, expected a value",
+		},
+		{
+			name:       "lhs which is not a field",
+			conditions: []Condition{{LHS: `lower(payload.a)`, RHS: "1"}},
+			wantErr:    "condition 0: lhs must be the path of a field, e.g. `payload[\"source\"]`, got \"lower(payload.a)\"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Compile(c.conditions)
+			gotErr := ""
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != c.wantErr {
+				t.Fatalf("expected error %q, got %q", c.wantErr, gotErr)
+			}
+			if got != c.want {
+				t.Fatalf("expected %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestDecompile(t *testing.T) {
+	cases := []struct {
+		src  string
+		want []Condition
+	}{
+		{src: ``, want: []Condition{}},
+		{src: `payload["foo"] == "bar"`, want: []Condition{{LHS: `payload["foo"]`, Op: OpIs, RHS: "bar"}}},
+		{
+			src: `payload.a != 'x' && !contains(payload.b, "y") && (payload.c =~ "^z" && endsWith(payload.d["e"], "w"))`,
+			want: []Condition{
+				{LHS: `payload.a`, Op: OpIsNot, RHS: "x"},
+				{LHS: `payload.b`, Op: OpNotContains, RHS: "y"},
+				{LHS: `payload.c`, Op: OpMatches, RHS: "^z"},
+				{LHS: `payload.d["e"]`, Op: OpEndsWith, RHS: "w"},
+			},
+		},
+		{src: `payload.a == "1" || payload.b == "2"`},
+		{src: `payload["event_id"] == 40`},
+		{src: `payload.a == payload.b`},
+		{src: `lower(payload.a) == "x"`},
+		{src: `payload.tags[payload.i] == "x"`},
+		{src: `!(payload.a == "x")`},
+		{src: `payload.a ==`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			got, ok := Decompile(c.src)
+			if ok != (c.want != nil) {
+				t.Fatalf("expected ok to be %t, got %t", c.want != nil, ok)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestCompileDecompile(t *testing.T) {
+	conditions := make([]Condition, len(Ops))
+	for i, op := range Ops {
+		conditions[i] = Condition{LHS: `payload.labels["severity"]`, Op: op, RHS: `a "quoted" \ value`}
+	}
+
+	src, err := Compile(conditions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, ok := Decompile(src)
+	if !ok {
+		t.Fatalf("could not decompile %s", src)
+	}
+	if !reflect.DeepEqual(got, conditions) {
+		t.Fatalf("expected %v, got %v", conditions, got)
+	}
+}
@@ -0,0 +1,395 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Env is the environment an expression is evaluated in, from the names of the roots to their values.
+// Values are the values of decoded JSON documents: nil, bool, float64, string, []any and map[string]any.
+type Env map[string]any
+
+// EvalError is an error which happened while evaluating an expression, at a 1-based column.
+type EvalError struct {
+	Column  int
+	Message string
+}
+
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Message)
+}
+
+// Match evaluates the expression and returns whether its value is truthy. An empty expression never matches.
+func Match(src string, env Env) (bool, error) {
+	if strings.TrimSpace(src) == "" {
+		return false, nil
+	}
+
+	node, err := Parse(src)
+	if err != nil {
+		return false, err
+	}
+
+	v, err := Eval(node, env)
+	if err != nil {
+		return false, err
+	}
+	return Truthy(v), nil
+}
+
+// Eval returns the value of the expression. Fields which do not exist evaluate to nil.
+func Eval(node Node, env Env) (any, error) {
+	switch n := node.(type) {
+	case *Literal:
+		return n.Value, nil
+
+	case *Ident:
+		return env[n.Name], nil
+
+	case *Member:
+		x, err := Eval(n.X, env)
+		if err != nil {
+			return nil, err
+		}
+		if m, ok := x.(map[string]any); ok {
+			return m[n.Field], nil
+		}
+		return nil, nil
+
+	case *Index:
+		x, err := Eval(n.X, env)
+		if err != nil {
+			return nil, err
+		}
+		index, err := Eval(n.Index, env)
+		if err != nil {
+			return nil, err
+		}
+		switch x := x.(type) {
+		case map[string]any:
+			return x[ToString(index)], nil
+		case []any:
+			if i, ok := index.(float64); ok && i == math.Trunc(i) && i >= 0 && int(i) < len(x) {
+				return x[int(i)], nil
+			}
+		}
+		return nil, nil
+
+	case *Array:
+		elems := make([]any, len(n.Elems))
+		for i, elem := range n.Elems {
+			v, err := Eval(elem, env)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = v
+		}
+		return elems, nil
+
+	case *Unary:
+		x, err := Eval(n.X, env)
+		if err != nil {
+			return nil, err
+		}
+		if n.Op == "!" {
+			return !Truthy(x), nil
+		}
+		f, ok := x.(float64)
+		if !ok {
+			return nil, &EvalError{Column: n.Column(), Message: fmt.Sprintf("cannot negate %s", describe(x))}
+		}
+		return -f, nil
+
+	case *Binary:
+		return evalBinary(n, env)
+
+	case *Call:
+		return evalCall(n, env)
+	}
+
+	return nil, fmt.Errorf("unknown node %T", node)
+}
+
+func evalBinary(n *Binary, env Env) (any, error) {
+	x, err := Eval(n.X, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case "&&":
+		if !Truthy(x) {
+			return false, nil
+		}
+		y, err := Eval(n.Y, env)
+		if err != nil {
+			return nil, err
+		}
+		return Truthy(y), nil
+	case "||":
+		if Truthy(x) {
+			return true, nil
+		}
+		y, err := Eval(n.Y, env)
+		if err != nil {
+			return nil, err
+		}
+		return Truthy(y), nil
+	}
+
+	y, err := Eval(n.Y, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.Op {
+	case "==":
+		return equal(x, y), nil
+	case "!=":
+		return !equal(x, y), nil
+
+	case "<", "<=", ">", ">=":
+		c, ok := compare(x, y)
+		if !ok {
+			return false, nil
+		}
+		switch n.Op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		}
+		return c >= 0, nil
+
+	case "=~", "!~":
+		re, err := compile(n.Y, y)
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(ToString(x)) == (n.Op == "=~"), nil
+
+	case "in":
+		return contains(y, x), nil
+
+	case "+":
+		if xs, ok := x.(string); ok {
+			return xs + ToString(y), nil
+		}
+		if ys, ok := y.(string); ok {
+			return ToString(x) + ys, nil
+		}
+	}
+
+	xf, xok := x.(float64)
+	yf, yok := y.(float64)
+	if !xok || !yok {
+		return nil, &EvalError{Column: n.Column(), Message: fmt.Sprintf("operator `%s` expects numbers, got %s and %s", n.Op, describe(x), describe(y))}
+	}
+
+	switch n.Op {
+	case "+":
+		return xf + yf, nil
+	case "-":
+		return xf - yf, nil
+	case "*":
+		return xf * yf, nil
+	}
+	if yf == 0 {
+		return nil, &EvalError{Column: n.Column(), Message: "division by zero"}
+	}
+	if n.Op == "/" {
+		return xf / yf, nil
+	}
+	return math.Mod(xf, yf), nil
+}
+
+func evalCall(n *Call, env Env) (any, error) {
+	if _, ok := Functions[n.Func]; !ok {
+		return nil, &EvalError{Column: n.Column(), Message: fmt.Sprintf("unknown function `%s`", n.Func)}
+	}
+
+	args := make([]any, len(n.Args))
+	for i, arg := range n.Args {
+		v, err := Eval(arg, env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	if len(args) < Functions[n.Func].MinArgs {
+		return nil, &EvalError{Column: n.Column(), Message: fmt.Sprintf("function `%s` takes %s, got %d", n.Func, arguments(Functions[n.Func]), len(args))}
+	}
+
+	switch n.Func {
+	case "re":
+		re, err := compile(n.Args[1], args[1])
+		if err != nil {
+			return nil, err
+		}
+		return re.MatchString(ToString(args[0])), nil
+	case "contains":
+		if s, ok := args[0].(string); ok {
+			return strings.Contains(s, ToString(args[1])), nil
+		}
+		return contains(args[0], args[1]), nil
+	case "startsWith":
+		return strings.HasPrefix(ToString(args[0]), ToString(args[1])), nil
+	case "endsWith":
+		return strings.HasSuffix(ToString(args[0]), ToString(args[1])), nil
+	case "lower":
+		return strings.ToLower(ToString(args[0])), nil
+	case "upper":
+		return strings.ToUpper(ToString(args[0])), nil
+	case "len":
+		switch v := args[0].(type) {
+		case string:
+			return float64(utf8.RuneCountInString(v)), nil
+		case []any:
+			return float64(len(v)), nil
+		case map[string]any:
+			return float64(len(v)), nil
+		}
+		return float64(0), nil
+	}
+
+	return nil, &EvalError{Column: n.Column(), Message: fmt.Sprintf("unknown function `%s`", n.Func)}
+}
+
+func compile(node Node, pattern any) (*regexp.Regexp, error) {
+	s, ok := pattern.(string)
+	if !ok {
+		return nil, &EvalError{Column: node.Column(), Message: fmt.Sprintf("expected a regular expression string, got %s", describe(pattern))}
+	}
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, &EvalError{Column: node.Column(), Message: fmt.Sprintf("invalid regular expression %s: %s", quote(s), strings.TrimPrefix(err.Error(), "error parsing regexp: "))}
+	}
+	return re, nil
+}
+
+// Truthy returns false for nil, false, 0, empty strings, empty arrays and empty objects, and true otherwise.
+func Truthy(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	}
+	return true
+}
+
+// ToString returns strings as they are, nil as an empty string, and other values in their JSON encoding.
+func ToString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// equal compares values of the same type, and numbers with the strings they are written as, e.g. `40` and `"40"`.
+func equal(x, y any) bool {
+	switch x := x.(type) {
+	case float64:
+		if ys, ok := y.(string); ok {
+			yf, err := strconv.ParseFloat(ys, 64)
+			return err == nil && x == yf
+		}
+	case string:
+		if _, ok := y.(float64); ok {
+			return equal(y, x)
+		}
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func compare(x, y any) (int, bool) {
+	switch x := x.(type) {
+	case float64:
+		if ys, ok := y.(string); ok {
+			yf, err := strconv.ParseFloat(ys, 64)
+			if err != nil {
+				return 0, false
+			}
+			y = yf
+		}
+		if yf, ok := y.(float64); ok {
+			switch {
+			case x < yf:
+				return -1, true
+			case x > yf:
+				return 1, true
+			}
+			return 0, true
+		}
+	case string:
+		switch y := y.(type) {
+		case string:
+			return strings.Compare(x, y), true
+		case float64:
+			c, ok := compare(y, x)
+			return -c, ok
+		}
+	}
+	return 0, false
+}
+
+// contains returns whether the array contains the value, the object has the value as a key, or the string contains the value.
+func contains(container, v any) bool {
+	switch c := container.(type) {
+	case []any:
+		for _, elem := range c {
+			if equal(elem, v) {
+				return true
+			}
+		}
+	case map[string]any:
+		_, ok := c[ToString(v)]
+		return ok
+	case string:
+		return strings.Contains(c, ToString(v))
+	}
+	return false
+}
+
+func describe(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "an array"
+	case map[string]any:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", v)
+}
@@ -0,0 +1,92 @@
+package expression
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	var payload map[string]any
+	err := json.Unmarshal([]byte(`{
+		"event_id": 40,
+		"status": "500",
+		"source": "Prometheus",
+		"message": "disk usage above 90% on web-12",
+		"labels": {"severity": "critical", "team": "sre"},
+		"tags": ["prod", "eu-west-1"],
+		"resolved": false,
+		"count": 3
+	}`), &payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := Env{"payload": payload}
+
+	cases := []struct {
+		src  string
+		want bool
+	}{
+		{src: ``, want: false},
+		{src: `payload["event_id"] == 40`, want: true},
+		{src: `payload.event_id == "40"`, want: true},
+		{src: `payload.status == 500`, want: true},
+		{src: `payload.status >= 500 && payload.status < 600`, want: true},
+		{src: `payload.source == "prometheus"`, want: false},
+		{src: `lower(payload.source) == "prometheus"`, want: true},
+		{src: `payload.labels.severity in ["critical", "high"]`, want: true},
+		{src: `"team" in payload.labels`, want: true},
+		{src: `"prod" in payload.tags && payload.tags[1] == "eu-west-1"`, want: true},
+		{src: `payload.tags[2] == null`, want: true},
+		{src: `payload.missing.field == null`, want: true},
+		{src: `payload.missing`, want: false},
+		{src: `!payload.resolved`, want: true},
+		{src: `payload.message =~ "web-\d+$"`, want: true},
+		{src: `payload.message !~ "web-\d+$"`, want: false},
+		{src: `re(payload.message, "^disk")`, want: true},
+		{src: `contains(payload.message, "90%") && startsWith(payload.message, "disk") && endsWith(payload.message, "12")`, want: true},
+		{src: `contains(payload.tags, "prod")`, want: true},
+		{src: `len(payload.tags) == 2 && len(payload.source) == 10`, want: true},
+		{src: `payload.count * 2 + 1 == 7 && payload.count % 2 == 1 && -payload.count < 0`, want: true},
+		{src: `payload.source + "/" + payload.labels.team == "Prometheus/sre"`, want: true},
+		{src: `payload.labels.severity == "critical" || payload.missing / 0`, want: true},
+		{src: `payload.labels.severity == "high" && payload.missing / 0`, want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			got, err := Match(c.src, env)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Fatalf("expected %t, got %t", c.want, got)
+			}
+		})
+	}
+}
+
+func TestMatchErrors(t *testing.T) {
+	env := Env{"payload": map[string]any{"count": float64(3), "pattern": "(", "source": "grafana"}}
+
+	cases := []struct {
+		src  string
+		want string
+	}{
+		{src: `payload.count ==`, want: "column 17: unexpected end of expression, expected a value"},
+		{src: `payload.count / 0 == 1`, want: "column 15: division by zero"},
+		{src: `payload.source * 2 == 1`, want: "column 16: operator `*` expects numbers, got a string and a number"},
+		{src: `-payload.source == 1`, want: "column 1: cannot negate a string"},
+		{src: `payload.source =~ payload.pattern`, want: "column 19: invalid regular expression \"(\": missing closing ): `(`"},
+		{src: `re(payload.source, payload.count)`, want: "column 20: expected a regular expression string, got a number"},
+		{src: `matches(payload.source, "x")`, want: "column 1: unknown function `matches`"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			_, err := Match(c.src, env)
+			if err == nil || err.Error() != c.want {
+				t.Fatalf("expected %q, got %v", c.want, err)
+			}
+		})
+	}
+}
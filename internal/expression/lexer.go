@@ -0,0 +1,206 @@
+package expression
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+	tokenPunct
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of expression"
+	case tokenIdent:
+		return "identifier"
+	case tokenNumber:
+		return "number"
+	case tokenString:
+		return "string"
+	}
+	return "token"
+}
+
+type token struct {
+	kind tokenKind
+	// text is the source text of the token, or the unquoted value of a string.
+	text string
+	// column is the 1-based column of the first character of the token.
+	column int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return t.kind.String()
+	case tokenString:
+		return "string " + quote(t.text)
+	}
+	return "`" + t.text + "`"
+}
+
+// operators are sorted so that the longest operators are matched first.
+var operators = []string{"==", "!=", "<=", ">=", "=~", "!~", "&&", "||", "<", ">", "!", "+", "-", "*", "/", "%"}
+
+const punctuation = "()[],."
+
+// lex splits the source into tokens, the last token is always tokenEOF.
+func lex(src string) ([]token, error) {
+	tokens := make([]token, 0)
+
+	column := 1
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		start := column
+
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			column++
+			continue
+
+		case r == '_' || unicode.IsLetter(r):
+			j := i
+			for j < len(src) {
+				r, size := utf8.DecodeRuneInString(src[j:])
+				if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				j += size
+				column++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: src[i:j], column: start})
+			i = j
+			continue
+
+		case r >= '0' && r <= '9':
+			j := i
+			seenDot := false
+			for j < len(src) {
+				c := src[j]
+				if c == '.' && !seenDot && j+1 < len(src) && src[j+1] >= '0' && src[j+1] <= '9' {
+					seenDot = true
+				} else if c < '0' || c > '9' {
+					break
+				}
+				j++
+				column++
+			}
+			// An exponent, e.g. 1.5e3 or 2E-4.
+			if j < len(src) && (src[j] == 'e' || src[j] == 'E') {
+				k := j + 1
+				if k < len(src) && (src[k] == '+' || src[k] == '-') {
+					k++
+				}
+				if k < len(src) && src[k] >= '0' && src[k] <= '9' {
+					for k < len(src) && src[k] >= '0' && src[k] <= '9' {
+						k++
+					}
+					column += k - j
+					j = k
+				}
+			}
+			if j < len(src) {
+				if r, _ := utf8.DecodeRuneInString(src[j:]); r == '_' || unicode.IsLetter(r) {
+					return nil, &SyntaxError{Column: column, Message: "invalid number " + quote(src[i:j+1])}
+				}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[i:j], column: start})
+			i = j
+			continue
+
+		case r == '"' || r == '\'':
+			value, n, width, err := lexString(src[i:], start)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: value, column: start})
+			i += n
+			column += width
+			continue
+
+		case strings.ContainsRune(punctuation, r):
+			tokens = append(tokens, token{kind: tokenPunct, text: string(r), column: start})
+			i += size
+			column++
+			continue
+		}
+
+		matched := false
+		for _, op := range operators {
+			if strings.HasPrefix(src[i:], op) {
+				tokens = append(tokens, token{kind: tokenOperator, text: op, column: start})
+				i += len(op)
+				column += len(op)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			if r == '=' || r == '&' || r == '|' {
+				return nil, &SyntaxError{Column: start, Message: "unexpected character " + quote(string(r)) + ", did you mean " + quote(strings.Repeat(string(r), 2)) + "?"}
+			}
+			return nil, &SyntaxError{Column: start, Message: "unexpected character " + quote(string(r))}
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, column: column}), nil
+}
+
+// lexString reads the quoted string at the start of src. It returns the unquoted value,
+// the number of bytes and the number of characters of the quoted string.
+func lexString(src string, column int) (string, int, int, error) {
+	quoteChar, _ := utf8.DecodeRuneInString(src)
+
+	var value strings.Builder
+	width := 1
+	for i := 1; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		i += size
+		width++
+
+		switch r {
+		case quoteChar:
+			return value.String(), i, width, nil
+		case '\\':
+			if i >= len(src) {
+				break
+			}
+			e, size := utf8.DecodeRuneInString(src[i:])
+			i += size
+			width++
+			switch e {
+			case 'n':
+				value.WriteRune('\n')
+			case 't':
+				value.WriteRune('\t')
+			case 'r':
+				value.WriteRune('\r')
+			case '\\', '"', '\'':
+				value.WriteRune(e)
+			default:
+				// Keep unknown escapes, e.g. `\d` in a regular expression.
+				value.WriteRune('\\')
+				value.WriteRune(e)
+			}
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	return "", 0, 0, &SyntaxError{Column: column, Message: "unterminated string"}
+}
+
+func quote(s string) string {
+	return "\"" + strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n", "\t", "\\t").Replace(s) + "\""
+}
@@ -0,0 +1,285 @@
+// Package expression parses and validates the expressions of Squadcast rules, e.g. routing, deduplication, suppression and tagging rules.
+//
+// The grammar of an expression is:
+//
+//	expression = binary
+//	binary     = unary { operator unary }
+//	operator   = "||" | "&&" | "==" | "!=" | "<" | "<=" | ">" | ">=" | "=~" | "!~" | "in" | "+" | "-" | "*" | "/" | "%"
+//	unary      = ( "!" | "-" ) unary | postfix
+//	postfix    = primary { "." identifier | "[" expression "]" }
+//	primary    = literal | identifier | call | array | "(" expression ")"
+//	call       = identifier "(" [ expression { "," expression } ] ")"
+//	array      = "[" [ expression { "," expression } ] "]"
+//	literal    = string | number | "true" | "false" | "null"
+//
+// Operators are listed by increasing precedence: `||`, `&&`, comparisons (`==`, `!=`, `<`, `<=`, `>`, `>=`, `=~`, `!~`, `in`),
+// `+` and `-`, then `*`, `/` and `%`. Strings are quoted with double or single quotes.
+package expression
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// SyntaxError is an error in an expression, at a 1-based column.
+type SyntaxError struct {
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("column %d: %s", e.Column, e.Message)
+}
+
+const (
+	precedenceOr = iota + 1
+	precedenceAnd
+	precedenceComparison
+	precedenceAdditive
+	precedenceMultiplicative
+	precedenceUnary
+)
+
+var precedence = map[string]int{
+	"||": precedenceOr,
+	"&&": precedenceAnd,
+	"==": precedenceComparison,
+	"!=": precedenceComparison,
+	"<":  precedenceComparison,
+	"<=": precedenceComparison,
+	">":  precedenceComparison,
+	">=": precedenceComparison,
+	"=~": precedenceComparison,
+	"!~": precedenceComparison,
+	"in": precedenceComparison,
+	"+":  precedenceAdditive,
+	"-":  precedenceAdditive,
+	"*":  precedenceMultiplicative,
+	"/":  precedenceMultiplicative,
+	"%":  precedenceMultiplicative,
+}
+
+var keywords = map[string]any{
+	"true":  true,
+	"false": false,
+	"null":  nil,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse returns the syntax tree of the expression, or a *SyntaxError.
+func Parse(src string) (Node, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, &SyntaxError{Column: 1, Message: "empty expression"}
+	}
+
+	node, err := p.parseBinary(precedenceOr)
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t, "an operator")
+	}
+
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) is(t token, text string) bool {
+	return (t.kind == tokenPunct || t.kind == tokenOperator) && t.text == text
+}
+
+func (p *parser) expect(text string) (token, error) {
+	t := p.next()
+	if !p.is(t, text) {
+		return t, p.unexpected(t, "`"+text+"`")
+	}
+	return t, nil
+}
+
+func (p *parser) unexpected(t token, expected string) error {
+	return &SyntaxError{Column: t.column, Message: fmt.Sprintf("unexpected %s, expected %s", t.describe(), expected)}
+}
+
+// binaryOperator returns the binary operator at the current position, if any.
+func (p *parser) binaryOperator() (string, bool) {
+	t := p.peek()
+	if t.kind == tokenOperator || (t.kind == tokenIdent && t.text == "in") {
+		if _, ok := precedence[t.text]; ok {
+			return t.text, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) parseBinary(minPrecedence int) (Node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op, ok := p.binaryOperator()
+		if !ok || precedence[op] < minPrecedence {
+			return x, nil
+		}
+		t := p.next()
+
+		y, err := p.parseBinary(precedence[op] + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if precedence[op] == precedenceComparison {
+			if b, ok := x.(*Binary); ok && precedence[b.Op] == precedenceComparison {
+				return nil, &SyntaxError{Column: t.column, Message: fmt.Sprintf("comparisons cannot be chained, use parentheses or `&&` to combine `%s` and `%s`", b.Op, op)}
+			}
+		}
+
+		x = &Binary{position: position(t.column), Op: op, X: x, Y: y}
+	}
+}
+
+func (p *parser) parseUnary() (Node, error) {
+	t := p.peek()
+	if p.is(t, "!") || p.is(t, "-") {
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{position: position(t.column), Op: t.text, X: x}, nil
+	}
+
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (Node, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		t := p.peek()
+		switch {
+		case p.is(t, "."):
+			p.next()
+			field := p.next()
+			if field.kind != tokenIdent {
+				return nil, p.unexpected(field, "a field name")
+			}
+			x = &Member{position: position(x.Column()), X: x, Field: field.text}
+		case p.is(t, "["):
+			p.next()
+			index, err := p.parseBinary(precedenceOr)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := p.expect("]"); err != nil {
+				return nil, err
+			}
+			x = &Index{position: position(x.Column()), X: x, Index: index}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) parseList(end string) ([]Node, error) {
+	nodes := make([]Node, 0)
+	if p.is(p.peek(), end) {
+		p.next()
+		return nodes, nil
+	}
+
+	for {
+		node, err := p.parseBinary(precedenceOr)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+
+		t := p.next()
+		if p.is(t, end) {
+			return nodes, nil
+		}
+		if !p.is(t, ",") {
+			return nil, p.unexpected(t, "`,` or `"+end+"`")
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (Node, error) {
+	t := p.next()
+	pos := position(t.column)
+
+	switch {
+	case t.kind == tokenString:
+		return &Literal{position: pos, Value: t.text}, nil
+
+	case t.kind == tokenNumber:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, &SyntaxError{Column: t.column, Message: "invalid number " + quote(t.text)}
+		}
+		return &Literal{position: pos, Value: v}, nil
+
+	case t.kind == tokenIdent:
+		if v, ok := keywords[t.text]; ok {
+			return &Literal{position: pos, Value: v}, nil
+		}
+		if t.text == "in" {
+			return nil, p.unexpected(t, "a value")
+		}
+		if p.is(p.peek(), "(") {
+			p.next()
+			args, err := p.parseList(")")
+			if err != nil {
+				return nil, err
+			}
+			return &Call{position: pos, Func: t.text, Args: args}, nil
+		}
+		return &Ident{position: pos, Name: t.text}, nil
+
+	case p.is(t, "["):
+		elems, err := p.parseList("]")
+		if err != nil {
+			return nil, err
+		}
+		return &Array{position: pos, Elems: elems}, nil
+
+	case p.is(t, "("):
+		x, err := p.parseBinary(precedenceOr)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	}
+
+	return nil, p.unexpected(t, "a value")
+}
@@ -0,0 +1,145 @@
+package expression
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		src  string
+		want string
+	}{
+		{src: `payload.source == "prometheus"`, want: `payload.source == "prometheus"`},
+		{src: `payload["source"]=='grafana'`, want: `payload["source"] == "grafana"`},
+		{src: `payload.labels.severity in ["critical", "high"]`, want: `payload.labels.severity in ["critical", "high"]`},
+		{src: `a || b && c`, want: `a || b && c`},
+		{src: `(a || b) && c`, want: `(a || b) && c`},
+		{src: `!(payload.resolved) && payload.count > 10`, want: `!payload.resolved && payload.count > 10`},
+		{src: `1 + 2 * 3 - 4`, want: `1 + 2 * 3 - 4`},
+		{src: `(1 + 2) * 3`, want: `(1 + 2) * 3`},
+		{src: `1 - (2 - 3)`, want: `1 - (2 - 3)`},
+		{src: `-payload.value < -1.5`, want: `-payload.value < -1.5`},
+		{src: `payload.latency > 1.5e3 || payload.ratio < 2E-4`, want: `payload.latency > 1500 || payload.ratio < 0.0002`},
+		{src: `re(payload.message, "timeout\d+")`, want: `re(payload.message, "timeout\\d+")`},
+		{src: `payload.host =~ "^web-" && payload.tags[0] != null`, want: `payload.host =~ "^web-" && payload.tags[0] != null`},
+		{src: `past.payload.alertname == current.payload.alertname`, want: `past.payload.alertname == current.payload.alertname`},
+		{src: `len([]) == 0 && true != false`, want: `len([]) == 0 && true != false`},
+		{src: `"say \"hi\"" == 'it\'s'`, want: `"say \"hi\"" == "it's"`},
+		{src: `payload.naïve == "ü"`, want: `payload.naïve == "ü"`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			node, err := Parse(c.src)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := node.String(); got != c.want {
+				t.Fatalf("expected %s, got %s", c.want, got)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		src    string
+		column int
+		want   string
+	}{
+		{src: ``, column: 1, want: "empty expression"},
+		{src: `payload.source = "x"`, column: 16, want: "unexpected character \"=\", did you mean \"==\"?"},
+		{src: `a & b`, column: 3, want: "unexpected character \"&\", did you mean \"&&\"?"},
+		{src: `payload.source == "x`, column: 19, want: "unterminated string"},
+		{src: `payload.source ==`, column: 18, want: "unexpected end of expression, expected a value"},
+		{src: `payload.source "x"`, column: 16, want: "unexpected string \"x\", expected an operator"},
+		{src: `(a == b`, column: 8, want: "unexpected end of expression, expected `)`"},
+		{src: `payload.`, column: 9, want: "unexpected end of expression, expected a field name"},
+		{src: `payload.1`, column: 9, want: "unexpected `1`, expected a field name"},
+		{src: `payload[0`, column: 10, want: "unexpected end of expression, expected `]`"},
+		{src: `re(a b)`, column: 6, want: "unexpected `b`, expected `,` or `)`"},
+		{src: `12abc == 1`, column: 3, want: "invalid number \"12a\""},
+		{src: `1e == 1`, column: 2, want: "invalid number \"1e\""},
+		{src: `1.5e3x == 1`, column: 6, want: "invalid number \"1.5e3x\""},
+		{src: `a == b == c`, column: 8, want: "comparisons cannot be chained, use parentheses or `&&` to combine `==` and `==`"},
+		{src: `a # b`, column: 3, want: "unexpected character \"#\""},
+		{src: `ü == 'ü' &&`, column: 12, want: "unexpected end of expression, expected a value"},
+		{src: `in == 1`, column: 1, want: "unexpected `in`, expected a value"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			_, err := Parse(c.src)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a syntax error, got %v", err)
+			}
+			if syntaxErr.Column != c.column || syntaxErr.Message != c.want {
+				t.Fatalf("expected column %d: %s, got %v", c.column, c.want, err)
+			}
+		})
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	// Member and index expressions start at the column of their operand, so that errors point at the whole field path.
+	cases := []struct {
+		src    string
+		column int
+	}{
+		{src: `payload.labels.host`, column: 1},
+		{src: `  payload["labels"][0]`, column: 3},
+		{src: `(payload).labels`, column: 2},
+		{src: `re(payload.host, "x").length`, column: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			node, err := Parse(c.src)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := node.Column(); got != c.column {
+				t.Fatalf("expected column %d, got %d", c.column, got)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		src   string
+		roots []string
+		want  string
+	}{
+		{src: ``, roots: PayloadRoots},
+		{src: `   `, roots: PayloadRoots},
+		{src: `payload.source == "prometheus"`, roots: PayloadRoots},
+		{src: `re(payload.message, "^(?i)disk")`, roots: PayloadRoots},
+		{src: `past.payload.host == current.payload.host`, roots: DeduplicationRoots},
+		{src: `contains(lower(payload.message), "timeout") || startsWith(payload.host, "db-")`, roots: PayloadRoots},
+		{src: `past.payload.host == current.payload.host`, roots: PayloadRoots, want: "column 1: unknown identifier `past`, expected one of `payload`"},
+		{src: `payloads.source == "x"`, roots: PayloadRoots, want: "column 1: unknown identifier `payloads`, expected one of `payload`"},
+		{src: `payload[source] == "x"`, roots: PayloadRoots, want: "column 9: unknown identifier `source`, expected one of `payload`"},
+		{src: `matches(payload.message, "x")`, roots: PayloadRoots, want: "column 1: unknown function `matches`, expected one of `contains`, `endsWith`, `len`, `lower`, `re`, `startsWith`, `upper`"},
+		{src: `re(payload.message)`, roots: PayloadRoots, want: "column 1: function `re` takes 2 arguments, got 1"},
+		{src: `lower(payload.a, payload.b) == "x"`, roots: PayloadRoots, want: "column 1: function `lower` takes 1 argument, got 2"},
+		{src: `re(payload.message, "disk (full")`, roots: PayloadRoots, want: "column 21: invalid regular expression \"disk (full\": missing closing ): `disk (full`"},
+		{src: `payload.host !~ "[a-"`, roots: PayloadRoots, want: "column 17: invalid regular expression \"[a-\": missing closing ]: `[a-`"},
+		{src: `payload.count =~ 1`, roots: PayloadRoots, want: "column 18: expected a regular expression string, got 1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			err := Validate(c.src, c.roots...)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != c.want {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package expression
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Roots of the expressions of rules which are evaluated against the payload of an incoming alert.
+var PayloadRoots = []string{"payload"}
+
+// Roots of the expressions of deduplication rules, which compare the payload of the current alert with the payload of a past one.
+var DeduplicationRoots = []string{"payload", "current", "past"}
+
+// Function describes a function which can be called in an expression.
+type Function struct {
+	// MinArgs and MaxArgs are the number of arguments the function takes.
+	MinArgs int
+	MaxArgs int
+	// Pattern is the index of the argument which is a regular expression, or -1.
+	Pattern int
+}
+
+// Functions are the functions which can be called in an expression.
+var Functions = map[string]Function{
+	"re":         {MinArgs: 2, MaxArgs: 2, Pattern: 1},
+	"contains":   {MinArgs: 2, MaxArgs: 2, Pattern: -1},
+	"startsWith": {MinArgs: 2, MaxArgs: 2, Pattern: -1},
+	"endsWith":   {MinArgs: 2, MaxArgs: 2, Pattern: -1},
+	"lower":      {MinArgs: 1, MaxArgs: 1, Pattern: -1},
+	"upper":      {MinArgs: 1, MaxArgs: 1, Pattern: -1},
+	"len":        {MinArgs: 1, MaxArgs: 1, Pattern: -1},
+}
+
+// Validate parses the expression and checks that it only references the given roots, only calls known
+// functions with the right number of arguments, and that its regular expressions compile.
+// An empty expression is valid. The returned error is a *SyntaxError.
+func Validate(src string, roots ...string) error {
+	if strings.TrimSpace(src) == "" {
+		return nil
+	}
+
+	node, err := Parse(src)
+	if err != nil {
+		return err
+	}
+
+	return check(node, roots)
+}
+
+func check(node Node, roots []string) error {
+	switch n := node.(type) {
+	case *Literal:
+		return nil
+
+	case *Ident:
+		for _, root := range roots {
+			if n.Name == root {
+				return nil
+			}
+		}
+		return &SyntaxError{Column: n.Column(), Message: fmt.Sprintf("unknown identifier `%s`, expected one of %s", n.Name, list(roots))}
+
+	case *Member:
+		return check(n.X, roots)
+
+	case *Index:
+		if err := check(n.X, roots); err != nil {
+			return err
+		}
+		return check(n.Index, roots)
+
+	case *Array:
+		for _, elem := range n.Elems {
+			if err := check(elem, roots); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case *Unary:
+		return check(n.X, roots)
+
+	case *Binary:
+		if err := check(n.X, roots); err != nil {
+			return err
+		}
+		if err := check(n.Y, roots); err != nil {
+			return err
+		}
+		if n.Op == "=~" || n.Op == "!~" {
+			return checkPattern(n.Y)
+		}
+		return nil
+
+	case *Call:
+		f, ok := Functions[n.Func]
+		if !ok {
+			names := make([]string, 0, len(Functions))
+			for name := range Functions {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return &SyntaxError{Column: n.Column(), Message: fmt.Sprintf("unknown function `%s`, expected one of %s", n.Func, list(names))}
+		}
+		if len(n.Args) < f.MinArgs || len(n.Args) > f.MaxArgs {
+			return &SyntaxError{Column: n.Column(), Message: fmt.Sprintf("function `%s` takes %s, got %d", n.Func, arguments(f), len(n.Args))}
+		}
+		for _, arg := range n.Args {
+			if err := check(arg, roots); err != nil {
+				return err
+			}
+		}
+		if f.Pattern >= 0 && f.Pattern < len(n.Args) {
+			return checkPattern(n.Args[f.Pattern])
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// checkPattern checks that a literal regular expression compiles. Patterns which are not literals are only known at evaluation.
+func checkPattern(node Node) error {
+	literal, ok := node.(*Literal)
+	if !ok {
+		return nil
+	}
+	pattern, ok := literal.Value.(string)
+	if !ok {
+		return &SyntaxError{Column: literal.Column(), Message: fmt.Sprintf("expected a regular expression string, got %s", literal.String())}
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return &SyntaxError{Column: literal.Column(), Message: fmt.Sprintf("invalid regular expression %s: %s", quote(pattern), strings.TrimPrefix(err.Error(), "error parsing regexp: "))}
+	}
+	return nil
+}
+
+func arguments(f Function) string {
+	if f.MinArgs == f.MaxArgs {
+		if f.MinArgs == 1 {
+			return "1 argument"
+		}
+		return fmt.Sprintf("%d arguments", f.MinArgs)
+	}
+	return fmt.Sprintf("%d to %d arguments", f.MinArgs, f.MaxArgs)
+}
+
+func list(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceAlertSources() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Alert sources](https://support.squadcast.com/docs/alert-sources) are the monitoring, logging and ticketing tools from which Squadcast can receive alerts. " +
+			"Use this data source to get the catalog of alert sources supported by Squadcast.",
+		ReadContext: dataSourceAlertSourcesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"include_deprecated": {
+				Description: "Include the deprecated alert sources.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"include_private": {
+				Description: "Include the private alert sources.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"alert_sources": {
+				Description: "Alert sources, sorted by short name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Alert source id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "Alert source type.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"heading": {
+							Description: "Display name of the alert source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"short_name": {
+							Description: "Short name of the alert source, used as the key of `alert_source_endpoints` in `squadcast_service`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"version": {
+							Description: "Version of the ingestion API used by the alert source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"support_doc_url": {
+							Description: "URL of the integration guide of the alert source.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_key_only": {
+							Description: "Denotes if the alert source is configured with the API key of the service instead of a webhook URL.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_private": {
+							Description: "Denotes if the alert source is private.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_deprecated": {
+							Description: "Denotes if the alert source is deprecated.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertSourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	includeDeprecated := d.Get("include_deprecated").(bool)
+	includePrivate := d.Get("include_private").(bool)
+
+	tflog.Info(ctx, "Reading alert sources", tf.M{
+		"include_deprecated": includeDeprecated,
+		"include_private":    includePrivate,
+	})
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filtered := make([]*api.AlertSource, 0, len(alertSources))
+	for _, as := range alertSources {
+		if !as.IsValid {
+			continue
+		}
+		if as.IsDeprecated && !includeDeprecated {
+			continue
+		}
+		if as.IsPrivate && !includePrivate {
+			continue
+		}
+		filtered = append(filtered, as)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].ShortName != filtered[j].ShortName {
+			return filtered[i].ShortName < filtered[j].ShortName
+		}
+		return filtered[i].ID < filtered[j].ID
+	})
+
+	list, err := tf.EncodeSlice(filtered)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("alert_sources")
+	if err = d.Set("alert_sources", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
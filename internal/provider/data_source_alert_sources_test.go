@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAlertSources(t *testing.T) {
+	resourceName := "data.squadcast_alert_sources.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlertSourcesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "alert_sources.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "alert_sources.*", map[string]string{
+						"short_name":       "prometheus",
+						"heading":          "Prometheus",
+						"display_key_only": "false",
+						"is_deprecated":    "false",
+						"is_private":       "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccAlertSourcesDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "squadcast_alert_sources" "test" {}
+	`)
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceEntityRoutes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the routing rules of the services of a Team which route incidents to a user, escalation policy, squad or schedule, " +
+			"e.g. to check that an entity is not used anymore before removing it. The escalation policy of a service, which is used when no routing rule matches, is not a route.",
+		ReadContext: dataSourceEntityRoutesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"entity_id": {
+				Description:  "The id of the entity (user, escalation policy, squad or schedule).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"entity_type": {
+				Description:  "Type of the entity, one of `user`, `escalationpolicy`, `squad` or `schedule`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(routeToTypes, false),
+			},
+			"routes": {
+				Description: "Routing rules which route to the entity, sorted by service name and rule index.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_id": {
+							Description: "Service id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"service_name": {
+							Description: "Name of the Service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rule_index": {
+							Description: "Index of the routing rule in the routing rules of the service.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"compiled_expression": {
+							Description: "The advanced expression which is equivalent to the routing rule, empty when its basic expressions cannot be compiled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEntityRoutesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	entityID := d.Get("entity_id").(string)
+	entityType := d.Get("entity_type").(string)
+
+	tflog.Info(ctx, "Reading services", tf.M{
+		"team_id": teamID,
+	})
+	services, err := client.ListServices(ctx, teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.SliceStable(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	routes := make([]any, 0)
+	for _, service := range services {
+		tflog.Info(ctx, "Reading routing_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": service.ID,
+		})
+		routingRules, err := client.GetRoutingRules(ctx, service.ID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for i, rule := range routingRules.Rules {
+			if rule.RouteTo.EntityID != entityID || rule.RouteTo.EntityType != entityType {
+				continue
+			}
+			// A rule whose basic expressions cannot be compiled is listed without its expression.
+			compiled, _ := api.RuleExpression(rule.IsBasic, rule.Expression, rule.BasicExpression)
+			routes = append(routes, tf.M{
+				"service_id":          service.ID,
+				"service_name":        service.Name,
+				"rule_index":          i,
+				"compiled_expression": compiled,
+			})
+		}
+	}
+
+	d.SetId(teamID + ":" + entityType + ":" + entityID)
+	if err = d.Set("routes", routes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEntityRoutes(t *testing.T) {
+	resourceName := "data.squadcast_entity_routes.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEntityRoutesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f:escalationpolicy:5f8c4ff09b0ccd917237c04b"),
+					resource.TestCheckResourceAttr(resourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.service_id", "61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttrSet(resourceName, "routes.0.service_name"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.rule_index", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.compiled_expression", "payload[\"foo\"] == \"bar\""),
+				),
+			},
+		},
+	})
+}
+
+func testAccEntityRoutesDataSourceConfig() string {
+	return fmt.Sprintf(`
+resource "squadcast_routing_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+		route_to_id = "5f8891527f735f0a6646f3b6"
+		route_to_type = "user"
+	}
+
+	rules {
+		is_basic = true
+
+		basic_expressions {
+			lhs = "payload[\"foo\"]"
+			rhs = "bar"
+		}
+
+		route_to_id = "5f8c4ff09b0ccd917237c04b"
+		route_to_type = "escalationpolicy"
+	}
+}
+
+data "squadcast_entity_routes" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	entity_id = "5f8c4ff09b0ccd917237c04b"
+	entity_type = "escalationpolicy"
+
+	depends_on = [squadcast_routing_rules.test]
+}
+	`)
+}
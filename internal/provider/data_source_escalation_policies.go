@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceEscalationPolicies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Escalation Policies of a Team, optionally filtered by name. Use the `squadcast_escalation_policy` data source to get the rules of a policy.",
+		ReadContext: dataSourceEscalationPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name_regex": dataSourceNameRegexSchema("Escalation Policies of the team"),
+			"escalation_policies": {
+				Description: "Escalation Policies, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Escalation policy id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Escalation Policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Detailed description about the Escalation Policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"team_id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEscalationPoliciesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading escalation policies", tf.M{
+		"team_id":    teamID.(string),
+		"name_regex": d.Get("name_regex").(string),
+	})
+	escalationPolicies, err := client.ListEscalationPolicies(ctx, teamID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	escalationPolicies, err = filterAndSortByName(d, escalationPolicies, func(ep *api.EscalationPolicy) (string, string) { return ep.Name, ep.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(escalationPolicies, dataSourceEscalationPolicies().Schema["escalation_policies"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID.(string))
+	if err = d.Set("escalation_policies", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
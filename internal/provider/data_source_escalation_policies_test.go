@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEscalationPolicies(t *testing.T) {
+	escalationPolicyName := acctest.RandomWithPrefix("escalation_policy")
+
+	resourceName := "data.squadcast_escalation_policies.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEscalationPoliciesDataSourceConfig(escalationPolicyName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "escalation_policies.0.id", "squadcast_escalation_policy.a", "id"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.0.name", escalationPolicyName+"-a"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.0.description", "It's an amazing policy"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.0.team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrPair(resourceName, "escalation_policies.1.id", "squadcast_escalation_policy.b", "id"),
+					resource.TestCheckResourceAttr(resourceName, "escalation_policies.1.name", escalationPolicyName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEscalationPoliciesDataSourceConfig(escalationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "b" {
+	name = "%s-b"
+	description = "It's an amazing policy"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b7"
+			type = "user"
+		}
+	}
+}
+
+resource "squadcast_escalation_policy" "a" {
+	name = "%s-a"
+	description = "It's an amazing policy"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b7"
+			type = "user"
+		}
+	}
+}
+
+data "squadcast_escalation_policies" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^%s-"
+
+	depends_on = [squadcast_escalation_policy.a, squadcast_escalation_policy.b]
+}
+	`, escalationPolicyName, escalationPolicyName, escalationPolicyName)
+}
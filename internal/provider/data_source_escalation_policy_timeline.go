@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/escalation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceEscalationPolicyTimeline() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the notifications an escalation policy sends, minute by minute, for an incident which is never acknowledged. " +
+			"Each rule starts `delay_minutes` after the previous rule, round robin rules are assumed to start with their first target, " +
+			"and a repeated policy runs again `repeat.delay_minutes` after the last notification of the previous run. " +
+			"Rules without notification channels notify their targets according to their personal notification rules, shown as the `Personal` channel.",
+
+		ReadContext: dataSourceEscalationPolicyTimelineRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "EscalationPolicy id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"escalation_policy_id": {
+				Description:  "EscalationPolicy id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"timeline": {
+				Description: "Notifications, ordered by minute.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minute": {
+							Description: "Minutes after the incident was triggered at which the notification is sent.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"policy_repetition": {
+							Description: "0 for the first run of the escalation policy, n for its n-th repetition.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"rule_index": {
+							Description: "Index of the rule which sends the notification.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"rule_repetition": {
+							Description: "0 for the first run of the rule, n for its n-th repetition.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"target_type": {
+							Description: "Type of the target, one of user, squad or schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"target_id": {
+							Description: "Id of the target.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"channel": {
+							Description: "Notification channel.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEscalationPolicyTimelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Get("escalation_policy_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	tflog.Info(ctx, "Reading escalation_policy timeline", tf.M{
+		"escalation_policy_id": id,
+		"team_id":              teamID,
+	})
+	escalationPolicy, err := client.GetEscalationPolicyById(ctx, teamID, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notifications := escalation.Timeline(escalationPolicy)
+	timeline := make([]any, len(notifications))
+	for i, n := range notifications {
+		timeline[i] = tf.M{
+			"minute":            n.Minute,
+			"policy_repetition": n.PolicyRepetition,
+			"rule_index":        n.RuleIndex,
+			"rule_repetition":   n.RuleRepetition,
+			"target_type":       n.TargetType,
+			"target_id":         n.TargetID,
+			"channel":           n.Channel,
+		}
+	}
+
+	d.SetId(escalationPolicy.ID)
+	if err = d.Set("timeline", timeline); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
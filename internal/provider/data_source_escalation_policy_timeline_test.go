@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceEscalationPolicyTimeline(t *testing.T) {
+	escalationPolicyName := acctest.RandomWithPrefix("escalationpolicy")
+
+	resourceName := "data.squadcast_escalation_policy_timeline.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEscalationPolicyTimelineConfig(escalationPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_escalation_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.0.minute", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.0.rule_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.0.target_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.0.target_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.0.channel", "Personal"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.1.minute", "5"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.1.rule_index", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.1.rule_repetition", "0"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.1.channel", "Phone"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.2.minute", "10"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.2.rule_repetition", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.3.minute", "20"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.3.policy_repetition", "1"),
+					resource.TestCheckResourceAttr(resourceName, "timeline.3.rule_index", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEscalationPolicyTimelineConfig(escalationPolicyName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+
+	repeat {
+		times = 1
+		delay_minutes = 10
+	}
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b6"
+			type = "user"
+		}
+	}
+
+	rules {
+		delay_minutes = 5
+		notification_channels = ["Phone"]
+
+		targets {
+			id = "5f8891527f735f0a6646f3b6"
+			type = "user"
+		}
+
+		repeat {
+			times = 1
+			delay_minutes = 5
+		}
+	}
+}
+
+data "squadcast_escalation_policy_timeline" "test" {
+	escalation_policy_id = squadcast_escalation_policy.test.id
+	team_id = squadcast_escalation_policy.test.team_id
+}
+	`, escalationPolicyName)
+}
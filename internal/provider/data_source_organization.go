@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get information about the organization to which the refresh token of the provider belongs.",
+		ReadContext: dataSourceOrganizationRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Organization id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "Name of the organization.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"slug": {
+				Description: "Slug of the organization, used in the URLs of the Squadcast web app.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"region": {
+				Description: "The region the organization is hosted on.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"api_base_url": {
+				Description: "Base URL of the Squadcast API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ingestion_base_url": {
+				Description: "Base URL to which alert sources send alerts.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"app_base_url": {
+				Description: "Base URL of the Squadcast web app.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading organization", tf.M{})
+	org, err := client.GetCurrentOrganization(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m, err := org.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m["region"] = client.Region
+	m["api_base_url"] = client.BaseURLV3
+	m["ingestion_base_url"] = client.IngestionBaseURL
+	m["app_base_url"] = client.AppBaseURL
+
+	if err = tf.SetState(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
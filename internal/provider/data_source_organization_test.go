@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOrganization(t *testing.T) {
+	resourceName := "data.squadcast_organization.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "slug"),
+					resource.TestCheckResourceAttrSet(resourceName, "region"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_base_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingestion_base_url"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_base_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "squadcast_organization" "test" {}
+	`)
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const ruleExpressionID = "rule_expression"
+
+func dataSourceRuleExpression() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to convert between the `basic_expressions` and the `expression` of routing, deduplication, suppression and tagging rules, without calling the Squadcast API. " +
+			"Conditions of basic expressions are combined with `&&`, e.g. `lhs = \"payload[\\\"source\\\"]\"`, `op = \"is\"` and `rhs = \"prometheus\"` compile to `payload[\"source\"] == \"prometheus\"`. " +
+			"Only expressions made of such comparisons, combined with `&&`, can be decompiled into basic expressions.",
+
+		ReadContext: dataSourceRuleExpressionRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"basic_expressions": {
+				Description:  "Conditions of a basic expression to compile.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"basic_expressions", "expression"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lhs": {
+							Description: "lhs, the path of a field of the payload, e.g. `payload[\"source\"]`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"op": {
+							Description:  "op, one of `" + strings.Join(expression.Ops, "`, `") + "`. Conditions of routing rules have no op and compare with `is`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      expression.OpIs,
+							ValidateFunc: validation.StringInSlice(expression.Ops, false),
+						},
+						"rhs": {
+							Description: "rhs.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"expression": {
+				Description:      "Expression to decompile.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"basic_expressions", "expression"},
+				ValidateDiagFunc: validateExpression(expression.DeduplicationRoots...),
+			},
+			"compiled_expression": {
+				Description: "The expression compiled from `basic_expressions`, or `expression` in its canonical form.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_basic": {
+				Description: "Whether the expression can be written as basic expressions.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"decompiled_basic_expressions": {
+				Description: "Conditions of the basic expression which is equivalent to the expression, empty when `is_basic` is false.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lhs": {
+							Description: "lhs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"op": {
+							Description: "op.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"rhs": {
+							Description: "rhs.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRuleExpressionRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	compiled := d.Get("expression").(string)
+
+	if _, ok := d.GetOk("basic_expressions"); ok {
+		mconditions := tf.ListToSlice[tf.M](d.Get("basic_expressions"))
+		conditions := make([]expression.Condition, len(mconditions))
+		for i, mcondition := range mconditions {
+			conditions[i] = expression.Condition{
+				LHS: mcondition["lhs"].(string),
+				Op:  mcondition["op"].(string),
+				RHS: mcondition["rhs"].(string),
+			}
+		}
+
+		var err error
+		compiled, err = expression.Compile(conditions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if node, err := expression.Parse(compiled); err == nil {
+		compiled = node.String()
+	}
+
+	conditions, isBasic := expression.Decompile(compiled)
+	decompiled := make([]any, len(conditions))
+	for i, c := range conditions {
+		decompiled[i] = tf.M{
+			"lhs": c.LHS,
+			"op":  c.Op,
+			"rhs": c.RHS,
+		}
+	}
+
+	d.SetId(ruleExpressionID)
+	if err := d.Set("compiled_expression", compiled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_basic", isBasic); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("decompiled_basic_expressions", decompiled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRuleExpression(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRuleExpressionConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.basic", "compiled_expression", "payload[\"source\"] == \"prometheus\" && !contains(payload.message, \"test\")"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.basic", "is_basic", "true"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.basic", "decompiled_basic_expressions.#", "2"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "compiled_expression", "payload.severity =~ \"^(critical|high)$\" && endsWith(payload[\"host\"], \".prod\")"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "is_basic", "true"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.#", "2"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.0.lhs", "payload.severity"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.0.op", "matches"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.0.rhs", "^(critical|high)$"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.1.lhs", "payload[\"host\"]"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.1.op", "ends_with"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.advanced", "decompiled_basic_expressions.1.rhs", ".prod"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.or", "compiled_expression", "payload.a == \"1\" || payload.b == \"2\""),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.or", "is_basic", "false"),
+					resource.TestCheckResourceAttr("data.squadcast_rule_expression.or", "decompiled_basic_expressions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRuleExpressionConfig() string {
+	return `
+data "squadcast_rule_expression" "basic" {
+	basic_expressions {
+		lhs = "payload[\"source\"]"
+		rhs = "prometheus"
+	}
+
+	basic_expressions {
+		lhs = "payload.message"
+		op = "not_contains"
+		rhs = "test"
+	}
+}
+
+data "squadcast_rule_expression" "advanced" {
+	expression = "payload.severity =~ '^(critical|high)$' && endsWith(payload[\"host\"],'.prod')"
+}
+
+data "squadcast_rule_expression" "or" {
+	expression = "payload.a == '1' || (payload.b == '2')"
+}
+	`
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/evaluation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+const rulesEvaluationID = "rules_evaluation"
+
+func dataSourceRulesEvaluation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to evaluate the rules of a service against sample alert payloads, e.g. to assert what the rules do in `terraform test` or CI before applying them. " +
+			"Rules are evaluated by the provider, the same way for every payload, in order, as if the payloads were alerts received by the service one after the other: " +
+			"a payload is suppressed when any suppression rule matches it, and suppressed payloads never open an incident. " +
+			"Otherwise, a payload is deduplicated when a deduplication rule matches it and the payload of an open incident, i.e. an earlier payload which was neither suppressed nor deduplicated, " +
+			"assuming all payloads are received within the time window of the rule. Deduplication rules are tried in order, against the most recent incident first. " +
+			"The first routing rule which matches routes the payload, and every tagging rule which matches adds its tags, with the fields of the payload interpolated in their values, the last rule wins when several rules add the same key. " +
+			"Rules without expression never match. Rule sets which are not configured are fetched from the API when `service_id` is set.",
+
+		ReadContext: dataSourceRulesEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id, required with `service_id`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				RequiredWith: []string{"service_id"},
+			},
+			"service_id": {
+				Description:  "Id of the service whose rule sets which are not configured are fetched from the API.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				RequiredWith: []string{"team_id"},
+			},
+			"deduplication_rules": {
+				Description: "Deduplication rules, with the same schema as the `rules` of `squadcast_deduplication_rules`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        resourceDeduplicationRules().Schema["rules"].Elem,
+			},
+			"suppression_rules": {
+				Description: "Suppression rules, with the same schema as the `rules` of `squadcast_suppression_rules`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        resourceSuppressionRules().Schema["rules"].Elem,
+			},
+			"routing_rules": {
+				Description: "Routing rules, with the same schema as the `rules` of `squadcast_routing_rules`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        resourceRoutingRules().Schema["rules"].Elem,
+			},
+			"tagging_rules": {
+				Description: "Tagging rules, with the same schema as the `rules` of `squadcast_tagging_rules`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        resourceTaggingRules().Schema["rules"].Elem,
+			},
+			"payloads": {
+				Description: "Sample alert payloads, as JSON documents, e.g. `jsonencode({ source = \"prometheus\" })`.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+			"results": {
+				Description: "Results, one per payload, in the order of `payloads`. Indexes of rules and payloads are -1 when nothing matched.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"suppressed": {
+							Description: "Whether the payload is suppressed.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"suppression_rule_index": {
+							Description: "Index of the first suppression rule which matched.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"deduplicated": {
+							Description: "Whether the payload is deduplicated.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"deduplication_rule_index": {
+							Description: "Index of the deduplication rule which matched.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"deduplicated_with": {
+							Description: "Index of the payload of the incident the payload is deduplicated with.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"routing_rule_index": {
+							Description: "Index of the routing rule which matched.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"route_to_id": {
+							Description: "The id of the entity the payload is routed to, empty when no routing rule matched.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"route_to_type": {
+							Description: "Type of the entity the payload is routed to, empty when no routing rule matched.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tagging_rule_indexes": {
+							Description: "Indexes of the tagging rules which matched.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"tags": {
+							Description: "Tags added to the payload, ordered by key.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "key",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"value": {
+										Description: "value",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"color": {
+										Description: "Tag color, hex values",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRulesEvaluationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	rules := &evaluation.Rules{}
+	if mrules := d.Get("deduplication_rules").([]any); len(mrules) > 0 || serviceID == "" {
+		if err := Decode(mrules, &rules.Deduplication); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		tflog.Info(ctx, "Reading deduplication_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+		deduplicationRules, err := client.GetDeduplicationRules(ctx, serviceID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Deduplication = deduplicationRules.Rules
+	}
+
+	if mrules := d.Get("suppression_rules").([]any); len(mrules) > 0 || serviceID == "" {
+		if err := Decode(mrules, &rules.Suppression); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		tflog.Info(ctx, "Reading suppression_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+		suppressionRules, err := client.GetSuppressionRules(ctx, serviceID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Suppression = suppressionRules.Rules
+	}
+
+	if mrules := d.Get("routing_rules").([]any); len(mrules) > 0 || serviceID == "" {
+		routingRules, err := decodeRoutingRules(ctx, client, teamID, mrules)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Routing = make([]*api.RoutingRule, len(routingRules))
+		for i := range routingRules {
+			rules.Routing[i] = &routingRules[i]
+		}
+	} else {
+		tflog.Info(ctx, "Reading routing_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+		routingRules, err := client.GetRoutingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Routing = routingRules.Rules
+	}
+
+	if mrules := d.Get("tagging_rules").([]any); len(mrules) > 0 || serviceID == "" {
+		var taggingRules []api.TaggingRule
+		if err := decodeTaggingRules(mrules, &taggingRules); err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Tagging = make([]*api.TaggingRule, len(taggingRules))
+		for i := range taggingRules {
+			rules.Tagging[i] = &taggingRules[i]
+		}
+	} else {
+		tflog.Info(ctx, "Reading tagging_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+		taggingRules, err := client.GetTaggingRules(ctx, serviceID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules.Tagging = taggingRules.Rules
+	}
+
+	mpayloads := tf.ListToSlice[string](d.Get("payloads"))
+	payloads := make([]any, len(mpayloads))
+	for i, mpayload := range mpayloads {
+		if err := json.Unmarshal([]byte(mpayload), &payloads[i]); err != nil {
+			return diag.FromErr(fmt.Errorf("payload %d: %w", i, err))
+		}
+	}
+
+	evaluations, err := evaluation.Evaluate(rules, payloads)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]any, len(evaluations))
+	for i, e := range evaluations {
+		keys := make([]string, 0, len(e.Tags))
+		for key := range e.Tags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		tags := make([]any, len(keys))
+		for j, key := range keys {
+			tags[j] = tf.M{
+				"key":   key,
+				"value": e.Tags[key].Value,
+				"color": e.Tags[key].Color,
+			}
+		}
+
+		routeTo := &api.RouteTo{}
+		if e.RouteTo != nil {
+			routeTo = e.RouteTo
+		}
+
+		results[i] = tf.M{
+			"suppressed":               e.Suppressed,
+			"suppression_rule_index":   e.SuppressionRule,
+			"deduplicated":             e.Deduplicated,
+			"deduplication_rule_index": e.DeduplicationRule,
+			"deduplicated_with":        e.DeduplicatedWith,
+			"routing_rule_index":       e.RoutingRule,
+			"route_to_id":              routeTo.EntityID,
+			"route_to_type":            routeTo.EntityType,
+			"tagging_rule_indexes":     e.TaggingRules,
+			"tags":                     tags,
+		}
+	}
+
+	d.SetId(rulesEvaluationID)
+	if err = d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRulesEvaluation(t *testing.T) {
+	resourceName := "data.squadcast_rules_evaluation.test"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRulesEvaluationConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "results.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.suppressed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.deduplicated", "false"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.routing_rule_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.route_to_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.route_to_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.tagging_rule_indexes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.tags.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.tags.0.key", "tier"),
+					resource.TestCheckResourceAttr(resourceName, "results.0.tags.0.value", "database"),
+					resource.TestCheckResourceAttr(resourceName, "results.1.deduplicated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "results.1.deduplication_rule_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "results.1.deduplicated_with", "0"),
+					resource.TestCheckResourceAttr(resourceName, "results.2.suppressed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "results.2.suppression_rule_index", "0"),
+					resource.TestCheckResourceAttr(resourceName, "results.2.routing_rule_index", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "results.2.route_to_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "results.2.tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRulesEvaluationConfig() string {
+	return `
+data "squadcast_rules_evaluation" "test" {
+	deduplication_rules {
+		is_basic = false
+		expression = "past.payload.host == current.payload.host"
+	}
+
+	suppression_rules {
+		is_basic = true
+
+		basic_expressions {
+			lhs = "payload.env"
+			op = "is"
+			rhs = "staging"
+		}
+	}
+
+	routing_rules {
+		is_basic = true
+
+		basic_expressions {
+			lhs = "payload[\"source\"]"
+			rhs = "prometheus"
+		}
+
+		route_to_id = "5f8891527f735f0a6646f3b6"
+		route_to_type = "user"
+	}
+
+	tagging_rules {
+		is_basic = false
+		expression = "startsWith(payload.host, 'db-')"
+
+		tags {
+			key = "tier"
+			value = "database"
+			color = "#ababab"
+		}
+	}
+
+	payloads = [
+		jsonencode({ source = "prometheus", host = "db-1" }),
+		jsonencode({ source = "prometheus", host = "db-1" }),
+		jsonencode({ source = "grafana", host = "web-1", env = "staging" }),
+	]
+}
+	`
+}
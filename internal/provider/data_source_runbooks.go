@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceRunbooks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Runbooks of a Team, optionally filtered by name.",
+		ReadContext: dataSourceRunbooksRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name_regex": dataSourceNameRegexSchema("Runbooks of the team"),
+			"runbooks": {
+				Description: "Runbooks, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Runbook id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Runbook.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"team_id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"steps": {
+							Description: "Steps of the runbook.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"content": {
+										Description: "Step content.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRunbooksRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading runbooks", tf.M{
+		"team_id":    teamID.(string),
+		"name_regex": d.Get("name_regex").(string),
+	})
+	runbooks, err := client.ListRunbooks(ctx, teamID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runbooks, err = filterAndSortByName(d, runbooks, func(r *api.Runbook) (string, string) { return r.Name, r.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(runbooks, dataSourceRunbooks().Schema["runbooks"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID.(string))
+	if err = d.Set("runbooks", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRunbooks(t *testing.T) {
+	runbookName := acctest.RandomWithPrefix("runbook")
+
+	resourceName := "data.squadcast_runbooks.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRunbooksDataSourceConfig(runbookName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "runbooks.0.id", "squadcast_runbook.a", "id"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.0.name", runbookName+"-a"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.0.team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.0.steps.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.0.steps.0.content", "some text here"),
+					resource.TestCheckResourceAttrPair(resourceName, "runbooks.1.id", "squadcast_runbook.b", "id"),
+					resource.TestCheckResourceAttr(resourceName, "runbooks.1.name", runbookName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRunbooksDataSourceConfig(runbookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_runbook" "b" {
+	name = "%s-b"
+	team_id = "613611c1eb22db455cfa789f"
+
+	steps {
+		content = "some text here"
+	}
+}
+
+resource "squadcast_runbook" "a" {
+	name = "%s-a"
+	team_id = "613611c1eb22db455cfa789f"
+
+	steps {
+		content = "some text here"
+	}
+}
+
+data "squadcast_runbooks" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^%s-"
+
+	depends_on = [squadcast_runbook.a, squadcast_runbook.b]
+}
+	`, runbookName, runbookName, runbookName)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Schedules of a Team, optionally filtered by name.",
+		ReadContext: dataSourceSchedulesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name_regex": dataSourceNameRegexSchema("Schedules of the team"),
+			"schedules": {
+				Description: "Schedules, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Schedule id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Detailed description about the schedule.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"color": {
+							Description: "Color of the schedule, hex value.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"team_id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading schedules", tf.M{
+		"team_id":    teamID.(string),
+		"name_regex": d.Get("name_regex").(string),
+	})
+	schedules, err := client.ListSchedules(ctx, teamID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	schedules, err = filterAndSortByName(d, schedules, func(s *api.Schedule) (string, string) { return s.Name, s.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(schedules, dataSourceSchedules().Schema["schedules"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID.(string))
+	if err = d.Set("schedules", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
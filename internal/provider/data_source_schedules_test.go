@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSchedules(t *testing.T) {
+	scheduleName := acctest.RandomWithPrefix("schedule")
+
+	resourceName := "data.squadcast_schedules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesDataSourceConfig(scheduleName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "schedules.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "schedules.0.id", "squadcast_schedule.a", "id"),
+					resource.TestCheckResourceAttr(resourceName, "schedules.0.name", scheduleName+"-a"),
+					resource.TestCheckResourceAttr(resourceName, "schedules.0.color", "#9900ef"),
+					resource.TestCheckResourceAttr(resourceName, "schedules.0.team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttrPair(resourceName, "schedules.1.id", "squadcast_schedule.b", "id"),
+					resource.TestCheckResourceAttr(resourceName, "schedules.1.name", scheduleName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSchedulesDataSourceConfig(scheduleName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_schedule" "b" {
+	name = "%s-b"
+	team_id = "613611c1eb22db455cfa789f"
+	color = "#9900ef"
+}
+
+resource "squadcast_schedule" "a" {
+	name = "%s-a"
+	team_id = "613611c1eb22db455cfa789f"
+	color = "#9900ef"
+}
+
+data "squadcast_schedules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^%s-"
+
+	depends_on = [squadcast_schedule.a, squadcast_schedule.b]
+}
+	`, scheduleName, scheduleName, scheduleName)
+}
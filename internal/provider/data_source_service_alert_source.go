@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceServiceAlertSource() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the endpoint to which an alert source has to send the alerts of a specific service, along with the details of the alert source.",
+		ReadContext: dataSourceServiceAlertSourceRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"short_name": {
+				Description:  "Short name of the alert source, e.g. `prometheus`. Use the `squadcast_alert_sources` data source to get the supported alert sources.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"endpoint": {
+				Description: "Webhook URL to which the alert source has to send alerts. This is the API key of the service when `display_key_only` is true, and the email of the service for the `email` alert source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"alert_source_id": {
+				Description: "Alert source id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "Alert source type.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"heading": {
+				Description: "Display name of the alert source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"version": {
+				Description: "Version of the ingestion API used by the alert source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"support_doc_url": {
+				Description: "URL of the integration guide of the alert source.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"display_key_only": {
+				Description: "Denotes if the alert source is configured with the API key of the service instead of a webhook URL.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_private": {
+				Description: "Denotes if the alert source is private.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_deprecated": {
+				Description: "Denotes if the alert source is deprecated.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceServiceAlertSourceRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	serviceID, ok := d.GetOk("service_id")
+	if !ok {
+		return diag.Errorf("invalid service id provided")
+	}
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	shortName, ok := d.GetOk("short_name")
+	if !ok {
+		return diag.Errorf("invalid alert source short name provided")
+	}
+
+	tflog.Info(ctx, "Reading service alert source", tf.M{
+		"service_id": serviceID.(string),
+		"short_name": shortName.(string),
+	})
+	service, err := client.GetServiceById(ctx, teamID.(string), serviceID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	alertSource, err := client.GetAlertSourceByShortName(ctx, shortName.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m, err := alertSource.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m["alert_source_id"] = m["id"]
+	m["id"] = service.ID + ":" + alertSource.ShortName
+	m["endpoint"] = alertSource.Endpoint(client.IngestionBaseURL, service)
+
+	if err = tf.SetState(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if alertSource.IsDeprecated {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Deprecated alert source",
+				Detail:   "The alert source `" + alertSource.ShortName + "` is deprecated, consider moving to one of its supported alternatives.",
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceServiceAlertSource(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "data.squadcast_service_alert_source.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAlertSourceDataSourceConfig(serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "alert_source_id"),
+					resource.TestCheckResourceAttr(resourceName, "short_name", "prometheus"),
+					resource.TestCheckResourceAttr(resourceName, "heading", "Prometheus"),
+					resource.TestCheckResourceAttr(resourceName, "display_key_only", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint", "squadcast_service.test", "alert_source_endpoints.prometheus"),
+					resource.TestMatchResourceAttr(resourceName, "endpoint", regexp.MustCompile(`/incidents/prometheus/`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceAlertSourceDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+data "squadcast_service_alert_source" "test" {
+	service_id = squadcast_service.test.id
+	team_id = "613611c1eb22db455cfa789f"
+	short_name = "prometheus"
+}
+	`, serviceName, serviceName)
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceServices() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Services of a Team, optionally filtered by name.",
+		ReadContext: dataSourceServicesRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name_regex": dataSourceNameRegexSchema("Services of the team"),
+			"services": {
+				Description: "Services, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Service id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Detailed description about the service.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"team_id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"escalation_policy_id": {
+							Description: "Escalation policy id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email_prefix": {
+							Description: "Email prefix.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "Email.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"api_key": {
+							Description: "Unique API key of the service",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"dependencies": {
+							Description: "dependencies.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceServicesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading services", tf.M{
+		"team_id":    teamID.(string),
+		"name_regex": d.Get("name_regex").(string),
+	})
+	services, err := client.ListServices(ctx, teamID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	services, err = filterAndSortByName(d, services, func(s *api.Service) (string, string) { return s.Name, s.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(services, dataSourceServices().Schema["services"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID.(string))
+	if err = d.Set("services", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceServices(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "data.squadcast_services.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicesDataSourceConfig(serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "services.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "services.0.id", "squadcast_service.a", "id"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.name", serviceName+"-a"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.escalation_policy_id", "61361415c2fc70c3101ca7db"),
+					resource.TestCheckResourceAttr(resourceName, "services.0.email_prefix", serviceName+"-a"),
+					resource.TestCheckResourceAttrPair(resourceName, "services.1.id", "squadcast_service.b", "id"),
+					resource.TestCheckResourceAttr(resourceName, "services.1.name", serviceName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicesDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "b" {
+	name = "%s-b"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s-b"
+}
+
+resource "squadcast_service" "a" {
+	name = "%s-a"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s-a"
+}
+
+data "squadcast_services" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^%s-"
+
+	depends_on = [squadcast_service.a, squadcast_service.b]
+}
+	`, serviceName, serviceName, serviceName, serviceName, serviceName)
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceSlo() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get information about a specific SLO.",
+		ReadContext: dataSourceSloRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the SLO.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "The name of the SLO.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "The team which SLO resource belongs to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"description": {
+				Description: "Description of the SLO.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"target_slo": {
+				Description: "The target SLO for the time period.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"service_ids": {
+				Description: "Service IDs associated with the SLO.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"slis": {
+				Description: "List of indentified SLIs for the SLO.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"time_interval_type": {
+				Description: "Type of the SLO, either \"rolling\" or \"fixed\".",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"duration_in_days": {
+				Description: "Tracks SLO for the last x days, set when the SLO time interval type is \"rolling\".",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"start_time": {
+				Description: "SLO start time, set when the SLO time interval type is \"fixed\".",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"end_time": {
+				Description: "SLO end time, set when the SLO time interval type is \"fixed\".",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rules": {
+				Description: "SLO monitoring checks.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the monitoring rule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"slo_id": {
+							Description: "The ID of the SLO.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of monitoring check.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"threshold": {
+							Description: "Threshold for the monitoring check.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"is_checked": {
+							Description: "Is checked?",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"notify": {
+				Description: "Notification rules for SLO violation.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the notification rule.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"slo_id": {
+							Description: "The ID of the SLO.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"user_ids": {
+							Description: "Set of user ID's who are alerted via email.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"squad_ids": {
+							Description: "Set of Squad ID's who are alerted via email.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"service_ids": {
+							Description: "Set of service ID's in which an incident is created.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	name, ok := d.GetOk("name")
+	if !ok {
+		return diag.Errorf("invalid slo name provided")
+	}
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading slo by name", tf.M{
+		"name":    name.(string),
+		"team_id": teamID.(string),
+	})
+	slo, err := client.GetSloByName(ctx, teamID.(string), name.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, alert := range slo.SloMonitoringChecks {
+		alert.Name = alertsMap[alert.Name]
+	}
+
+	if err = tf.EncodeAndSet(slo, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/errorbudget"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceSloStatus() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the current attainment and error budget of an SLO. " +
+			"The status is computed over the rolling or fixed window of the SLO, at the time the data source is read, from the incidents which violated the SLO. " +
+			"Incidents marked as false positives are ignored.",
+		ReadContext: dataSourceSloStatusRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the SLO.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"slo_id": {
+				Description: "The ID of the SLO.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"team_id": {
+				Description:  "The team which SLO resource belongs to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name": {
+				Description: "The name of the SLO.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"target_slo": {
+				Description: "The target SLO for the time period.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"window_start": {
+				Description: "Start of the window over which the status is computed (RFC3339).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"window_end": {
+				Description: "End of the window over which the status is computed (RFC3339).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sli_attainment": {
+				Description: "Percentage of the elapsed part of the window during which the SLO was met.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"error_budget_minutes": {
+				Description: "Downtime allowed over the whole window, in minutes.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"remaining_error_budget_minutes": {
+				Description: "Error budget left, in minutes. Negative once the error budget is exhausted.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"remaining_error_budget_percent": {
+				Description: "Error budget left, as a percentage of the error budget of the window.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"burn_rate": {
+				Description: "Rate at which the error budget is consumed, relative to the rate which exactly exhausts it at the end of the window. A burn rate above 1 exhausts the error budget before the end of the window.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"violating_incident_count": {
+				Description: "Number of incidents which violated the SLO during the window, excluding false positives.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSloStatusRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	sloID := d.Get("slo_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	tflog.Info(ctx, "Reading slo status", tf.M{
+		"slo_id":  sloID,
+		"team_id": teamID,
+	})
+	slo, err := client.GetSlo(ctx, teamID, sloID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	now := time.Now().UTC()
+	window, err := errorbudget.NewWindow(slo.TimeIntervalType, slo.DurationInDays, slo.StartTime, slo.EndTime, now)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sloIncidents, err := client.ListSloIncidents(ctx, teamID, sloID, window.Start, window.End)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	incidents := make([]errorbudget.Incident, 0, len(sloIncidents))
+	for _, incident := range sloIncidents {
+		i := errorbudget.Incident{
+			Start:         incident.CreatedAt,
+			FalsePositive: incident.IsFalsePositive,
+		}
+		if incident.ResolvedAt != nil {
+			i.End = *incident.ResolvedAt
+		}
+		incidents = append(incidents, i)
+	}
+
+	status, err := errorbudget.Compute(slo.TargetSlo, window, incidents, now)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(sloID)
+	err = tf.SetState(d, tf.M{
+		"name":                           slo.Name,
+		"target_slo":                     slo.TargetSlo,
+		"window_start":                   status.Window.Start.Format(time.RFC3339),
+		"window_end":                     status.Window.End.Format(time.RFC3339),
+		"sli_attainment":                 status.Attainment,
+		"error_budget_minutes":           status.ErrorBudget.Minutes(),
+		"remaining_error_budget_minutes": status.RemainingErrorBudget.Minutes(),
+		"remaining_error_budget_percent": status.RemainingErrorBudgetPercent,
+		"burn_rate":                      status.BurnRate,
+		"violating_incident_count":       status.ViolatingIncidents,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSloStatus(t *testing.T) {
+	sloName := acctest.RandomWithPrefix("terraform-acc-test-slo-")
+
+	resourceName := "data.squadcast_slo_status.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSloStatusDataSourceConfig(sloName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_slo.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", sloName),
+					resource.TestCheckResourceAttr(resourceName, "target_slo", "99.9"),
+					resource.TestCheckResourceAttrSet(resourceName, "window_start"),
+					resource.TestCheckResourceAttrSet(resourceName, "window_end"),
+					resource.TestCheckResourceAttr(resourceName, "sli_attainment", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "error_budget_minutes"),
+					resource.TestCheckResourceAttrPair(resourceName, "remaining_error_budget_minutes", resourceName, "error_budget_minutes"),
+					resource.TestCheckResourceAttr(resourceName, "remaining_error_budget_percent", "100"),
+					resource.TestCheckResourceAttr(resourceName, "burn_rate", "0"),
+					resource.TestCheckResourceAttr(resourceName, "violating_incident_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSloStatusDataSourceConfig(sloName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_slo" "test" {
+	name = "%s"
+	target_slo = 99.9
+	service_ids = ["6257a8eb3c8ff45615ce5f2e"]
+	slis = ["latency"]
+	time_interval_type = "rolling"
+	duration_in_days = 30
+	team_id = "61443b953ffd52818bf1616a"
+}
+
+data "squadcast_slo_status" "test" {
+	slo_id = squadcast_slo.test.id
+	team_id = squadcast_slo.test.team_id
+}
+	`, sloName)
+}
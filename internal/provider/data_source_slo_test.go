@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSlo(t *testing.T) {
+	sloName := acctest.RandomWithPrefix("terraform-acc-test-slo-")
+
+	resourceName := "data.squadcast_slo.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSloDataSourceConfig(sloName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_slo.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", sloName),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "61443b953ffd52818bf1616a"),
+					resource.TestCheckResourceAttr(resourceName, "target_slo", "99.9"),
+					resource.TestCheckResourceAttr(resourceName, "time_interval_type", "rolling"),
+					resource.TestCheckResourceAttr(resourceName, "duration_in_days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "slis.0", "latency"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "breached_error_budget"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notify.0.user_ids.*", "6113b0ffe4d98ae048c37010"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSloDataSourceConfig(sloName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_slo" "test" {
+	name = "%s"
+	target_slo = 99.9
+	service_ids = ["6257a8eb3c8ff45615ce5f2e"]
+	slis = ["latency"]
+	time_interval_type = "rolling"
+	duration_in_days = 30
+
+	rules {
+		name = "breached_error_budget"
+	}
+
+	notify {
+		user_ids = ["6113b0ffe4d98ae048c37010"]
+	}
+
+	team_id = "61443b953ffd52818bf1616a"
+}
+
+data "squadcast_slo" "test" {
+	name = squadcast_slo.test.name
+	team_id = squadcast_slo.test.team_id
+}
+	`, sloName)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceSquads() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Squads of a Team, optionally filtered by name.",
+		ReadContext: dataSourceSquadsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"name_regex": dataSourceNameRegexSchema("Squads of the team"),
+			"squads": {
+				Description: "Squads, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Squad id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Squad.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"team_id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"member_ids": {
+							Description: "User ids of the squad members.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSquadsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading squads", tf.M{
+		"team_id":    teamID.(string),
+		"name_regex": d.Get("name_regex").(string),
+	})
+	squads, err := client.ListSquads(ctx, teamID.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	squads, err = filterAndSortByName(d, squads, func(s *api.Squad) (string, string) { return s.Name, s.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(squads, dataSourceSquads().Schema["squads"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID.(string))
+	if err = d.Set("squads", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
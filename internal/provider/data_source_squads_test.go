@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSquads(t *testing.T) {
+	squadName := acctest.RandomWithPrefix("squad")
+
+	resourceName := "data.squadcast_squads.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSquadsDataSourceConfig(squadName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "squads.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "squads.0.id", "squadcast_squad.a", "id"),
+					resource.TestCheckResourceAttr(resourceName, "squads.0.name", squadName+"-a"),
+					resource.TestCheckResourceAttr(resourceName, "squads.0.team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "squads.0.member_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "squads.0.member_ids.0", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttrPair(resourceName, "squads.1.id", "squadcast_squad.b", "id"),
+					resource.TestCheckResourceAttr(resourceName, "squads.1.name", squadName+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSquadsDataSourceConfig(squadName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_squad" "b" {
+	name = "%s-b"
+	team_id = "613611c1eb22db455cfa789f"
+	member_ids = ["5f8891527f735f0a6646f3b6"]
+}
+
+resource "squadcast_squad" "a" {
+	name = "%s-a"
+	team_id = "613611c1eb22db455cfa789f"
+	member_ids = ["5f8891527f735f0a6646f3b6"]
+}
+
+data "squadcast_squads" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^%s-"
+
+	depends_on = [squadcast_squad.a, squadcast_squad.b]
+}
+	`, squadName, squadName, squadName)
+}
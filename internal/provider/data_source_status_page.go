@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceStatusPage() *schema.Resource {
+	return &schema.Resource{
+		Description: "Status Pages let you communicate the health of your services to your customers. " +
+			"Use this data source to get information about a specific Status page, including its components and component groups.",
+		ReadContext: dataSourceStatusPageRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Status page id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Status page.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"description": {
+				Description: "Detailed description about the Status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"is_public": {
+				Description: "Denotes if the status page is publicly accessible.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"domain_name": {
+				Description: "Subdomain on which the status page is hosted by Squadcast.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"custom_domain_name": {
+				Description: "Custom domain name for the status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "Public URL of the status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"timezone": {
+				Description: "IANA time zone in which the status page displays times.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logo_url": {
+				Description: "URL of the logo displayed on the status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"contact_email": {
+				Description: "Support email displayed on the status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hide_from_search_engines": {
+				Description: "Denotes if search engines are prevented from indexing the status page.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"theme_color": {
+				Description: "Branding colors of the status page.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secondary": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"component_groups": {
+				Description: "Component groups of the status page.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_page_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"components": {
+				Description: "Components of the status page.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_page_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	name, ok := d.GetOk("name")
+	if !ok {
+		return diag.Errorf("invalid status page name provided")
+	}
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading status page by name", tf.M{
+		"name": name.(string),
+	})
+	statusPage, err := client.GetStatusPageByName(ctx, teamID.(string), name.(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	m, err := statusPage.EncodeWithComponents()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = tf.SetState(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
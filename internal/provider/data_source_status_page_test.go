@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceStatusPage(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resourceName := "data.squadcast_status_page.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusPageDataSourceConfig(statusPageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", statusPageName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", statusPageName),
+					resource.TestCheckResourceAttr(resourceName, "component_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "component_groups.0.name", "Backend"),
+					resource.TestCheckResourceAttr(resourceName, "components.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "components.0.name", "API"),
+					resource.TestCheckResourceAttrPair(resourceName, "components.0.group_id", "squadcast_status_page_component_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusPageDataSourceConfig(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+
+resource "squadcast_status_page_component_group" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "Backend"
+}
+
+resource "squadcast_status_page_component" "test" {
+	status_page_id = squadcast_status_page.test.id
+	group_id = squadcast_status_page_component_group.test.id
+	name = "API"
+}
+
+data "squadcast_status_page" "test" {
+	name = squadcast_status_page.test.name
+	team_id = "613611c1eb22db455cfa789f"
+
+	depends_on = [squadcast_status_page_component.test]
+}
+	`, statusPageName, statusPageName)
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTeamRoles(t *testing.T) {
+	resourceName := "data.squadcast_team_roles.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamRolesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.id", "613611c1eb22db455cfa789b"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.name", "Manage Team"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.abilities.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.abilities.0", "delete-teams"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.abilities.1", "read-teams"),
+					resource.TestCheckResourceAttr(resourceName, "team_roles.0.abilities.2", "update-teams"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamRolesDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "squadcast_team_roles" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	name_regex = "^Manage Team$"
+}
+	`)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceTeams() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of Teams of the organization, optionally filtered by name.",
+		ReadContext: dataSourceTeamsRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name_regex": dataSourceNameRegexSchema("Teams"),
+			"teams": {
+				Description: "Teams, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Team id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the Team.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "Detailed description about the Team.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"default": {
+							Description: "Denotes if this is the default team of the organization.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNameRegexSchema(entities string) *schema.Schema {
+	return &schema.Schema{
+		Description:  "Regular expression which the name has to match. All " + entities + " are returned when this is not set.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// filterAndSortByName keeps the items whose name matches `name_regex` and sorts them by name, and then by id,
+// so that the output of list data sources does not change when the API returns the items in a different order.
+func filterAndSortByName[T any](d *schema.ResourceData, items []T, key func(T) (string, string)) ([]T, error) {
+	var re *regexp.Regexp
+	if nameRegex, ok := d.GetOk("name_regex"); ok {
+		var err error
+		re, err = regexp.Compile(nameRegex.(string))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		name, _ := key(item)
+		if re != nil && !re.MatchString(name) {
+			continue
+		}
+		filtered = append(filtered, item)
+	}
+
+	sort.SliceStable(filtered, func(i, j int) bool {
+		iName, iID := key(filtered[i])
+		jName, jID := key(filtered[j])
+		if iName != jName {
+			return iName < jName
+		}
+		return iID < jID
+	})
+
+	return filtered, nil
+}
+
+// encodeList encodes the items, dropping the attributes which are not part of the element schema of the list.
+func encodeList[T tf.StateEncoder](items []T, elem *schema.Resource) ([]any, error) {
+	list, err := tf.EncodeSlice(items)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range list {
+		m := item.(tf.M)
+		for k := range m {
+			if _, ok := elem.Schema[k]; !ok {
+				delete(m, k)
+			}
+		}
+	}
+
+	return list, nil
+}
+
+func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading teams", tf.M{
+		"name_regex": d.Get("name_regex").(string),
+	})
+	teams, err := client.ListTeams(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teams, err = filterAndSortByName(d, teams, func(t *api.Team) (string, string) { return t.Name, t.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(teams, dataSourceTeams().Schema["teams"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(client.OrganizationID)
+	if err = d.Set("teams", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceTeams(t *testing.T) {
+	resourceName := "data.squadcast_teams.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "teams.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "teams.0.id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "teams.0.name", "Default Team"),
+					resource.TestCheckResourceAttr(resourceName, "teams.0.description", "Default team"),
+					resource.TestCheckResourceAttr(resourceName, "teams.0.default", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamsDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "squadcast_teams" "test" {
+	name_regex = "^Default Team$"
+}
+	`)
+}
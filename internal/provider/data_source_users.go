@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to get the list of users of the organization, optionally filtered by name, team membership and role.",
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name_regex": dataSourceNameRegexSchema("users"),
+			"team_id": {
+				Description:  "Only return the members of this team.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"role": {
+				Description:  "Only return the users with this role. Supported values are \"account_owner\", \"user\" and \"stakeholder\"",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"account_owner", "user", "stakeholder"}, false),
+			},
+			"users": {
+				Description: "Users, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "User id.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"first_name": {
+							Description: "User first name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"last_name": {
+							Description: "User last name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"email": {
+							Description: "User email.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"role": {
+							Description: "User role.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"abilities": {
+							Description: "Denotes the Permissions / abilities of the user.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	teamID := d.Get("team_id").(string)
+	role := d.Get("role").(string)
+
+	tflog.Info(ctx, "Reading users", tf.M{
+		"name_regex": d.Get("name_regex").(string),
+		"team_id":    teamID,
+		"role":       role,
+	})
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var members map[string]bool
+	if teamID != "" {
+		team, err := client.GetTeamById(ctx, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		members = make(map[string]bool, len(team.Members))
+		for _, member := range team.Members {
+			members[member.UserID] = true
+		}
+	}
+
+	filtered := make([]*api.ResourceUser, 0, len(users))
+	for _, user := range users {
+		if members != nil && !members[user.ID] {
+			continue
+		}
+		if role != "" && user.Role != role {
+			continue
+		}
+		filtered = append(filtered, user)
+	}
+
+	filtered, err = filterAndSortByName(d, filtered, func(u *api.ResourceUser) (string, string) { return u.FirstName + " " + u.LastName, u.ID })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	list, err := encodeList(filtered, dataSourceUsers().Schema["users"].Elem.(*schema.Resource))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(client.OrganizationID)
+	if err = d.Set("users", list); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestAccDataSourceUsers(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resourceName := "data.squadcast_users.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig(user),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "users.0.id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.0.first_name", user.FirstName),
+					resource.TestCheckResourceAttr(resourceName, "users.0.last_name", user.LastName),
+					resource.TestCheckResourceAttr(resourceName, "users.0.email", user.Email),
+					resource.TestCheckResourceAttr(resourceName, "users.0.role", "stakeholder"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "stakeholder"
+}
+
+data "squadcast_users" "test" {
+	name_regex = "^${squadcast_user.test.first_name} "
+	role = "stakeholder"
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
 func init() {
@@ -30,31 +31,62 @@ func New(version string) func() *schema.Provider {
 	return func() *schema.Provider {
 		p := &schema.Provider{
 			DataSourcesMap: map[string]*schema.Resource{
-				"squadcast_squad":             dataSourceSquad(),
-				"squadcast_service":           dataSourceService(),
-				"squadcast_escalation_policy": dataSourceEscalationPolicy(),
-				// "squadcast_teams": dataSourceTeams(),
-				"squadcast_team":     dataSourceTeam(),
-				"squadcast_user":     dataSourceUser(),
-				"squadcast_schedule": dataSourceSchedule(),
-				"squadcast_runbook":  dataSourceRunbook(),
+				"squadcast_squad":                      dataSourceSquad(),
+				"squadcast_service":                    dataSourceService(),
+				"squadcast_escalation_policy":          dataSourceEscalationPolicy(),
+				"squadcast_teams":                      dataSourceTeams(),
+				"squadcast_team":                       dataSourceTeam(),
+				"squadcast_user":                       dataSourceUser(),
+				"squadcast_schedule":                   dataSourceSchedule(),
+				"squadcast_runbook":                    dataSourceRunbook(),
+				"squadcast_status_page":                dataSourceStatusPage(),
+				"squadcast_services":                   dataSourceServices(),
+				"squadcast_users":                      dataSourceUsers(),
+				"squadcast_squads":                     dataSourceSquads(),
+				"squadcast_schedules":                  dataSourceSchedules(),
+				"squadcast_escalation_policies":        dataSourceEscalationPolicies(),
+				"squadcast_escalation_policy_timeline": dataSourceEscalationPolicyTimeline(),
+				"squadcast_runbooks":                   dataSourceRunbooks(),
+				"squadcast_team_roles":                 dataSourceTeamRoles(),
+				"squadcast_alert_sources":              dataSourceAlertSources(),
+				"squadcast_service_alert_source":       dataSourceServiceAlertSource(),
+				"squadcast_organization":               dataSourceOrganization(),
+				"squadcast_slo":                        dataSourceSlo(),
+				"squadcast_slo_status":                 dataSourceSloStatus(),
+				"squadcast_rule_expression":            dataSourceRuleExpression(),
+				"squadcast_rules_evaluation":           dataSourceRulesEvaluation(),
+				"squadcast_entity_routes":              dataSourceEntityRoutes(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"squadcast_deduplication_rules": resourceDeduplicationRules(),
-				"squadcast_escalation_policy":   resourceEscalationPolicy(),
-				"squadcast_routing_rules":       resourceRoutingRules(),
-				"squadcast_runbook":             resourceRunbook(),
-				"squadcast_schedule":            resourceSchedule(),
-				"squadcast_service_maintenance": resourceServiceMaintenance(),
-				"squadcast_service":             resourceService(),
-				"squadcast_squad":               resourceSquad(),
-				"squadcast_suppression_rules":   resourceSuppressionRules(),
-				"squadcast_tagging_rules":       resourceTaggingRules(),
-				"squadcast_team_member":         resourceTeamMember(),
-				"squadcast_team_role":           resourceTeamRole(),
-				"squadcast_team":                resourceTeam(),
-				"squadcast_user":                resourceUser(),
-				"squadcast_slo":                 resourceSlo(),
+				"squadcast_deduplication_rules":         resourceDeduplicationRules(),
+				"squadcast_deduplication_rule":          resourceDeduplicationRule(),
+				"squadcast_escalation_policy":           resourceEscalationPolicy(),
+				"squadcast_routing_rules":               resourceRoutingRules(),
+				"squadcast_routing_rule":                resourceRoutingRule(),
+				"squadcast_runbook":                     resourceRunbook(),
+				"squadcast_schedule":                    resourceSchedule(),
+				"squadcast_service_maintenance":         resourceServiceMaintenance(),
+				"squadcast_service":                     resourceService(),
+				"squadcast_service_alert_sources":       resourceServiceAlertSources(),
+				"squadcast_squad":                       resourceSquad(),
+				"squadcast_suppression_rules":           resourceSuppressionRules(),
+				"squadcast_suppression_rule":            resourceSuppressionRule(),
+				"squadcast_tagging_rules":               resourceTaggingRules(),
+				"squadcast_tagging_rule":                resourceTaggingRule(),
+				"squadcast_tag":                         resourceTag(),
+				"squadcast_team_member":                 resourceTeamMember(),
+				"squadcast_team_role":                   resourceTeamRole(),
+				"squadcast_team":                        resourceTeam(),
+				"squadcast_user":                        resourceUser(),
+				"squadcast_user_notification_rules":     resourceUserNotificationRules(),
+				"squadcast_user_profile":                resourceUserProfile(),
+				"squadcast_slo":                         resourceSlo(),
+				"squadcast_webhook":                     resourceWebhook(),
+				"squadcast_status_page":                 resourceStatusPage(),
+				"squadcast_status_page_component_group": resourceStatusPageComponentGroup(),
+				"squadcast_status_page_component":       resourceStatusPageComponent(),
+				"squadcast_global_event_ruleset":        resourceGlobalEventRuleset(),
+				"squadcast_global_event_rule":           resourceGlobalEventRule(),
 			},
 			Schema: map[string]*schema.Schema{
 				"region": {
@@ -72,6 +104,18 @@ func New(version string) func() *schema.Provider {
 					Required:    true,
 					DefaultFunc: schema.EnvDefaultFunc("SQUADCAST_REFRESH_TOKEN", nil),
 				},
+				"expected_organization_id": {
+					Description:  "When set, the provider fails to configure if the refresh token belongs to an organization with a different id. This protects against applying a configuration against the wrong organization.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+				"strict_tags": {
+					Description: "When true, tagging rules may only add the tags of the organization, see `squadcast_tag`. The tags are read at plan time, so tags created in the same apply are not known yet.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+				},
 			},
 		}
 
@@ -81,6 +125,19 @@ func New(version string) func() *schema.Provider {
 	}
 }
 
+// checkExpectedOrganization returns an error when an organization is expected and the refresh token belongs to another one.
+func checkExpectedOrganization(expectedOrgID string, org *api.Organization) *diag.Diagnostic {
+	if expectedOrgID == "" || expectedOrgID == org.ID {
+		return nil
+	}
+
+	return &diag.Diagnostic{
+		Severity: diag.Error,
+		Summary:  "The refresh token belongs to a different organization.",
+		Detail:   fmt.Sprintf("expected organization `%s`, but the refresh token belongs to the organization `%s` (%s).", expectedOrgID, org.Name, org.ID),
+	}
+}
+
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (any, diag.Diagnostics) {
 	return func(ctx context.Context, rd *schema.ResourceData) (c any, diags diag.Diagnostics) {
 		client := &api.Client{}
@@ -90,6 +147,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		refreshToken := rd.Get("refresh_token").(string)
 
 		client.RefreshToken = refreshToken
+		client.Region = region
 
 		switch region {
 		case "us":
@@ -109,11 +167,13 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			client.BaseURLV2 = fmt.Sprintf("http://%s:8080/v2", client.Host)
 			client.AuthBaseURL = fmt.Sprintf("http://%s:8081/v3", client.Host)
 			client.IngestionBaseURL = fmt.Sprintf("http://%s:8458", client.Host)
+			client.AppBaseURL = fmt.Sprintf("http://%s:3000", client.Host)
 		} else {
 			client.BaseURLV3 = fmt.Sprintf("https://api.%s/v3", client.Host)
 			client.BaseURLV2 = fmt.Sprintf("https://platform-backend.%s/v2", client.Host)
 			client.AuthBaseURL = fmt.Sprintf("https://api.%s/v3", client.Host)
 			client.IngestionBaseURL = fmt.Sprintf("https://api.%s", client.Host)
+			client.AppBaseURL = fmt.Sprintf("https://app.%s", client.Host)
 		}
 
 		token, err := client.GetAccessToken(ctx)
@@ -134,7 +194,12 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 				Detail:   err.Error(),
 			})
 		}
+
+		if d := checkExpectedOrganization(rd.Get("expected_organization_id").(string), org); d != nil {
+			return nil, append(diags, *d)
+		}
 		client.OrganizationID = org.ID
+		client.StrictTags = rd.Get("strict_tags").(bool)
 
 		return client, nil
 	}
@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
 )
 
 var testAccProvider = New("dev")()
@@ -36,3 +40,38 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAccProviderExpectedOrganizationID(t *testing.T) {
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("acceptance tests are skipped unless env '%s' is set", resource.EnvTfAcc)
+	}
+	testAccPreCheck(t)
+
+	diags := New("dev")().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]any{
+		"expected_organization_id": "000000000000000000000000",
+	}))
+
+	if !diags.HasError() || diags[0].Summary != "The refresh token belongs to a different organization." {
+		t.Fatalf("expected configure to fail because the refresh token belongs to a different organization, got: %v", diags)
+	}
+}
+
+func TestCheckExpectedOrganization(t *testing.T) {
+	org := &api.Organization{ID: "613611c1eb22db455cfa789f", Name: "Squadcast"}
+
+	if d := checkExpectedOrganization("", org); d != nil {
+		t.Errorf("expected no error without an expected organization, got: %v", d)
+	}
+	if d := checkExpectedOrganization("613611c1eb22db455cfa789f", org); d != nil {
+		t.Errorf("expected no error for the same organization, got: %v", d)
+	}
+
+	d := checkExpectedOrganization("000000000000000000000000", org)
+	if d == nil || d.Severity != diag.Error {
+		t.Fatalf("expected an error for another organization, got: %v", d)
+	}
+	want := "expected organization `000000000000000000000000`, but the refresh token belongs to the organization `Squadcast` (613611c1eb22db455cfa789f)."
+	if d.Detail != want {
+		t.Errorf("got detail %q, want %q", d.Detail, want)
+	}
+}
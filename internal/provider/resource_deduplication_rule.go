@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// deduplicationServiceRule describes the deduplication rules of a service, see serviceRule.
+func deduplicationServiceRule() *serviceRule[*api.DeduplicationRule] {
+	return &serviceRule[*api.DeduplicationRule]{
+		kind: "deduplication_rules",
+		rule: resourceDeduplicationRules().Schema["rules"].Elem.(*schema.Resource).Schema,
+		decode: func(ctx context.Context, client *api.Client, teamID string, m tf.M) (*api.DeduplicationRule, error) {
+			var rule api.DeduplicationRule
+			err := Decode(m, &rule)
+			normalizeDeduplicationWindow(&rule)
+			return &rule, err
+		},
+		list: func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]*api.DeduplicationRule, error) {
+			deduplicationRules, err := client.GetDeduplicationRules(ctx, serviceID, teamID)
+			if err != nil {
+				return nil, err
+			}
+			for _, rule := range deduplicationRules.Rules {
+				normalizeDeduplicationWindow(rule)
+			}
+			return deduplicationRules.Rules, nil
+		},
+		update: func(ctx context.Context, client *api.Client, serviceID, teamID string, rules []*api.DeduplicationRule) error {
+			req := &api.UpdateDeduplicationRulesReq{Rules: make([]api.DeduplicationRule, len(rules))}
+			for i, rule := range rules {
+				req.Rules[i] = *rule
+			}
+			_, err := client.UpdateDeduplicationRules(ctx, serviceID, teamID, req)
+			return err
+		},
+	}
+}
+
+func resourceDeduplicationRule() *schema.Resource {
+	r := deduplicationServiceRule().resource("A deduplication rule of a service. Unlike `squadcast_deduplication_rules`, which manages all the deduplication rules of a service, this resource only manages one rule and keeps the other rules of the service, so several modules can add rules to the same service. " +
+		"Rules do not have an id, the rule is found in the rules of the service by its content: a rule changed outside of Terraform is considered removed, and a rule identical to another rule of the service cannot be created. Must not be used together with `squadcast_deduplication_rules` for the same service.")
+	r.CustomizeDiff = customdiff.All(r.CustomizeDiff, resourceDeduplicationRuleCustomizeDiff)
+
+	create, update := r.CreateContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if err := checkDeduplicationRuleDependencies(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return create(ctx, d, meta)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if err := checkDeduplicationRuleDependencies(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+		return update(ctx, d, meta)
+	}
+
+	return r
+}
+
+func resourceDeduplicationRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return validateDeduplicationWindow(d.GetRawConfig(), "")
+}
+
+func checkDeduplicationRuleDependencies(ctx context.Context, d *schema.ResourceData, meta any) error {
+	keys := make([]string, 0)
+	if d.Get("dependency_deduplication").(bool) {
+		keys = append(keys, "dependency_deduplication")
+	}
+	return checkDeduplicationDependencies(ctx, meta.(*api.Client), d.Get("team_id").(string), d.Get("service_id").(string), keys)
+}
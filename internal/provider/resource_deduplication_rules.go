@@ -2,16 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-const deduplicationRulesID = "deduplication_rules"
-
 func resourceDeduplicationRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Deduplication rules](https://support.squadcast.com/docs/de-duplication-rules) can help you reduce alert noise by organising and grouping alerts. This also provides easy access to similar alerts when needed. When these rules evaluate to true for an incoming incident, alerts will get deduplicated.",
@@ -23,6 +26,7 @@ func resourceDeduplicationRules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDeduplicationRulesImport,
 		},
+		CustomizeDiff: resourceDeduplicationRulesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -44,6 +48,8 @@ func resourceDeduplicationRules() *schema.Resource {
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
+			"on_existing": onExistingSchema("deduplication_rules"),
+			"rules_hash":  rulesHashSchema(),
 			"rules": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -60,27 +66,37 @@ func resourceDeduplicationRules() *schema.Resource {
 							Optional:    true,
 						},
 						"expression": {
-							Description: "expression.",
+							Description:      "expression.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateExpression(expression.DeduplicationRoots...),
+						},
+						"compiled_expression": {
+							Description: "The advanced expression which is equivalent to the rule, compiled from `basic_expressions` when `is_basic` is true.",
 							Type:        schema.TypeString,
-							Optional:    true,
+							Computed:    true,
 						},
 						"dependency_deduplication": {
-							Description: "Denotes if dependent services should also be deduplicated",
+							Description: "Denotes if dependent services should also be deduplicated, the service must have `dependencies`.",
 							Type:        schema.TypeBool,
 							Optional:    true,
 							Default:     false,
 						},
 						"time_window": {
-							Description: "time window.",
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Default:     1,
+							Description:      "Time window during which incidents are deduplicated, in `time_unit`, at most 48 hours. Equivalent windows, e.g. 60 minutes and 1 hour, do not produce a diff.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          1,
+							ValidateFunc:     validation.IntAtLeast(1),
+							DiffSuppressFunc: suppressEquivalentDeduplicationWindow,
 						},
 						"time_unit": {
-							Description: "time unit.",
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "hour",
+							Description:      "Unit of `time_window`, `minute` or `hour`.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "hour",
+							ValidateFunc:     validation.StringInSlice([]string{"minute", "hour"}, false),
+							DiffSuppressFunc: suppressEquivalentDeduplicationWindow,
 						},
 						"basic_expressions": {
 							Description: "basic expression.",
@@ -95,9 +111,10 @@ func resourceDeduplicationRules() *schema.Resource {
 										Required:    true,
 									},
 									"op": {
-										Description: "op",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "op, one of `" + strings.Join(expression.Ops, "`, `") + "`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(expression.Ops, false),
 									},
 									"rhs": {
 										Description: "rhs",
@@ -122,11 +139,131 @@ func resourceDeduplicationRulesImport(ctx context.Context, d *schema.ResourceDat
 
 	d.Set("team_id", teamID)
 	d.Set("service_id", serviceID)
-	d.SetId(deduplicationRulesID)
+	d.Set("on_existing", onExistingOverwrite)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// deduplicationTimeUnits are the units of the time window of a deduplication rule, in minutes, from the largest.
+var deduplicationTimeUnits = []struct {
+	name    string
+	minutes int
+}{
+	{"hour", 60},
+	{"minute", 1},
+}
+
+// maxDeduplicationWindowMinutes is the longest time window of a deduplication rule, 48 hours.
+const maxDeduplicationWindowMinutes = 48 * 60
+
+// deduplicationWindowMinutes returns the length of a time window in minutes, or 0 when the unit is not known.
+func deduplicationWindowMinutes(window int, unit string) int {
+	for _, u := range deduplicationTimeUnits {
+		if u.name == unit {
+			return window * u.minutes
+		}
+	}
+	return 0
+}
+
+// normalizeDeduplicationWindow expresses the time window of a rule in the largest unit which divides it, e.g. 60 minutes as 1 hour, as the API does.
+func normalizeDeduplicationWindow(rule *api.DeduplicationRule) {
+	minutes := deduplicationWindowMinutes(rule.TimeWindow, rule.TimeUnit)
+	if minutes == 0 {
+		return
+	}
+	for _, u := range deduplicationTimeUnits {
+		if minutes%u.minutes == 0 {
+			rule.TimeWindow, rule.TimeUnit = minutes/u.minutes, u.name
+			return
+		}
+	}
+}
+
+// suppressEquivalentDeduplicationWindow suppresses the diff of `time_window` and `time_unit` when the old and new windows have the same length.
+func suppressEquivalentDeduplicationWindow(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")+1]
+
+	oldWindow, newWindow := d.GetChange(prefix + "time_window")
+	oldUnit, newUnit := d.GetChange(prefix + "time_unit")
+
+	oldMinutes := deduplicationWindowMinutes(oldWindow.(int), oldUnit.(string))
+	return oldMinutes != 0 && oldMinutes == deduplicationWindowMinutes(newWindow.(int), newUnit.(string))
+}
+
+// validateDeduplicationWindow checks, using the configuration, that the time window of a rule is not longer than the API allows.
+func validateDeduplicationWindow(rule cty.Value, prefix string) error {
+	window, ok := ctyInt(rule.GetAttr("time_window"))
+	if !ok {
+		if !rule.GetAttr("time_window").IsNull() {
+			return nil
+		}
+		window = 1
+	}
+	unit := rule.GetAttr("time_unit")
+	if !unit.IsKnown() {
+		return nil
+	}
+	unitName := "hour"
+	if !unit.IsNull() {
+		unitName = unit.AsString()
+	}
+
+	if minutes := deduplicationWindowMinutes(int(window), unitName); minutes > maxDeduplicationWindowMinutes {
+		return fmt.Errorf("%stime window must be at most %d hours (%d minutes), got %d %ss", prefix, maxDeduplicationWindowMinutes/60, maxDeduplicationWindowMinutes, window, unitName)
+	}
+	return nil
+}
+
+func resourceDeduplicationRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if err := validateRulesBasicExpressions(d); err != nil {
+		return err
+	}
+
+	rules := d.GetRawConfig().GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	for i, rule := range rules.AsValueSlice() {
+		if err := validateDeduplicationWindow(rule, fmt.Sprintf("rules.%d: ", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rulesWithDependencyDeduplication returns the keys of the rules which deduplicate the incidents of the dependent services.
+func rulesWithDependencyDeduplication(d *schema.ResourceData) []string {
+	keys := make([]string, 0)
+	for i, mrule := range tf.ListToSlice[tf.M](d.Get("rules")) {
+		if enabled, _ := mrule["dependency_deduplication"].(bool); enabled {
+			keys = append(keys, fmt.Sprintf("rules.%d", i))
+		}
+	}
+	return keys
+}
+
+// checkDeduplicationDependencies checks that the service has dependencies when the rules with the given keys deduplicate the incidents of the dependent services.
+// It is checked at apply rather than at plan, so that dependencies added to the service in the same apply are taken into account.
+func checkDeduplicationDependencies(ctx context.Context, client *api.Client, teamID, serviceID string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	service, err := client.GetServiceById(ctx, teamID, serviceID)
+	if err != nil {
+		return err
+	}
+	if len(service.Dependencies) == 0 {
+		return fmt.Errorf("%s: `dependency_deduplication` is enabled but the service %s has no dependencies, set the `dependencies` of the service or disable `dependency_deduplication`", strings.Join(keys, ", "), serviceID)
+	}
+
+	return nil
+}
+
 func resourceDeduplicationRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
@@ -135,18 +272,42 @@ func resourceDeduplicationRulesCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	for i := range rules {
+		normalizeDeduplicationWindow(&rules[i])
+	}
 
-	tflog.Info(ctx, "Creating deduplication_rules", tf.M{
-		"team_id":    d.Get("team_id").(string),
-		"service_id": d.Get("service_id").(string),
-	})
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	if err = checkDeduplicationDependencies(ctx, client, teamID, serviceID, rulesWithDependencyDeduplication(d)); err != nil {
+		return diag.FromErr(err)
+	}
 
-	_, err = client.UpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateDeduplicationRulesReq{Rules: rules})
+	unlock := lockServiceRules("deduplication_rules", serviceID)
+	defer unlock()
+
+	existing, err := client.GetDeduplicationRules(ctx, serviceID, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	adopt, err := adoptServiceRules(ctx, d, "deduplication_rules", len(existing.Rules))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !adopt {
+		tflog.Info(ctx, "Creating deduplication_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+
+		_, err = client.UpdateDeduplicationRules(ctx, serviceID, teamID, &api.UpdateDeduplicationRulesReq{Rules: rules})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	d.SetId(deduplicationRulesID)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return resourceDeduplicationRulesRead(ctx, d, meta)
 }
@@ -173,10 +334,23 @@ func resourceDeduplicationRulesRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	for _, rule := range deduplicationRules.Rules {
+		normalizeDeduplicationWindow(rule)
+	}
 
 	if err = tf.EncodeAndSet(deduplicationRules, d); err != nil {
 		return diag.FromErr(err)
 	}
+	// The rules have the id of their document in the API, the resource is identified by its service instead.
+	d.SetId(serviceRulesID(teamID.(string), serviceID.(string)))
+
+	rulesHash, err := serviceRulesHash(deduplicationRules.Rules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rules_hash", rulesHash); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -184,18 +358,17 @@ func resourceDeduplicationRulesRead(ctx context.Context, d *schema.ResourceData,
 func resourceDeduplicationRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var rules []api.DeduplicationRule
-	err := Decode(d.Get("rules"), &rules)
+	err := checkDeduplicationDependencies(ctx, client, d.Get("team_id").(string), d.Get("service_id").(string), rulesWithDependencyDeduplication(d))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	_, err = client.UpdateDeduplicationRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateDeduplicationRulesReq{Rules: rules})
-	if err != nil {
-		return diag.FromErr(err)
+	diags := deduplicationServiceRule().updateRules(ctx, client, d)
+	if diags.HasError() {
+		return diags
 	}
 
-	return resourceDeduplicationRulesRead(ctx, d, meta)
+	return append(diags, resourceDeduplicationRulesRead(ctx, d, meta)...)
 }
 
 func resourceDeduplicationRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
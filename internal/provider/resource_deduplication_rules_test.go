@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -28,6 +29,7 @@ func TestAccResourceDeduplicationRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.0.time_window", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.time_unit", "hour"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.compiled_expression", "payload[\"event_id\"] == 40"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
@@ -66,6 +68,7 @@ func TestAccResourceDeduplicationRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.lhs", "payload[\"foo\"]"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.op", "is"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.rhs", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.compiled_expression", "payload[\"foo\"] == \"bar\""),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.dependency_deduplication", "false"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.time_window", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.time_unit", "hour"),
@@ -83,6 +86,36 @@ func TestAccResourceDeduplicationRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceDeduplicationRules_timeWindow(t *testing.T) {
+	resourceName := "squadcast_deduplication_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckDeduplicationRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceDeduplicationRulesConfig_timeWindow(3, "day"),
+				ExpectError: regexp.MustCompile(`expected rules.0.time_unit to be one of \[minute hour\], got day`),
+			},
+			{
+				Config:      testAccResourceDeduplicationRulesConfig_timeWindow(49, "hour"),
+				ExpectError: regexp.MustCompile(`rules.0: time window must be at most 48 hours \(2880 minutes\), got 49 hours`),
+			},
+			{
+				Config: testAccResourceDeduplicationRulesConfig_timeWindow(120, "minute"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.time_window", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.time_unit", "hour"),
+				),
+			},
+			{
+				Config:   testAccResourceDeduplicationRulesConfig_timeWindow(2, "hour"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDeduplicationRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -162,3 +195,42 @@ resource "squadcast_deduplication_rules" "test" {
 }
 	`)
 }
+
+func testAccResourceDeduplicationRulesConfig_timeWindow(timeWindow int, timeUnit string) string {
+	return fmt.Sprintf(`
+resource "squadcast_deduplication_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+		time_window = %d
+		time_unit = "%s"
+	}
+}
+	`, timeWindow, timeUnit)
+}
+
+func TestNormalizeDeduplicationWindow(t *testing.T) {
+	cases := []struct {
+		window     int
+		unit       string
+		wantWindow int
+		wantUnit   string
+	}{
+		{60, "minute", 1, "hour"},
+		{90, "minute", 90, "minute"},
+		{2, "hour", 2, "hour"},
+		{2880, "minute", 48, "hour"},
+		{5, "day", 5, "day"},
+	}
+
+	for _, c := range cases {
+		rule := &api.DeduplicationRule{TimeWindow: c.window, TimeUnit: c.unit}
+		normalizeDeduplicationWindow(rule)
+		if rule.TimeWindow != c.wantWindow || rule.TimeUnit != c.wantUnit {
+			t.Errorf("%d %s: got %d %s, want %d %s", c.window, c.unit, rule.TimeWindow, rule.TimeUnit, c.wantWindow, c.wantUnit)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +22,7 @@ func resourceEscalationPolicy() *schema.Resource {
 		ReadContext:   resourceEscalationPolicyRead,
 		UpdateContext: resourceEscalationPolicyUpdate,
 		DeleteContext: resourceEscalationPolicyDelete,
+		CustomizeDiff: resourceEscalationPolicyCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceEscalationPolicyImport,
 		},
@@ -87,15 +90,37 @@ func resourceEscalationPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"id": {
+										Description:  "Target id, requires `type`. Conflicts with `user_email`, `squad_name` and `schedule_name`, it is computed when one of them is set.",
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
 										ValidateFunc: tf.ValidateObjectID,
 									},
 									"type": {
+										Description:  "Target type, one of `user`, `squad` or `schedule`. Required with `id`, it is computed when `user_email`, `squad_name` or `schedule_name` is set.",
 										Type:         schema.TypeString,
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
 										ValidateFunc: validation.StringInSlice([]string{"user", "squad", "schedule"}, false),
 									},
+									"user_email": {
+										Description:  "Email of the user to notify, the user must be a member of the team.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"squad_name": {
+										Description:  "Name of the squad to notify, within the team of the escalation policy.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									"schedule_name": {
+										Description:  "Name of the schedule to notify, within the team of the escalation policy.",
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
 								},
 							},
 						},
@@ -188,7 +213,247 @@ func resourceEscalationPolicyImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
-func decodeEscalationPolicyRules(mrules []tf.M) ([]api.EscalationPolicyRule, error) {
+// escalationPolicyTargetRefs are the attributes which reference a target by email or name, instead of `id` and `type`.
+var escalationPolicyTargetRefs = []string{"user_email", "squad_name", "schedule_name"}
+
+// resolveEscalationPolicyTarget returns the target referenced by `user_email`, `squad_name` or `schedule_name` within the team, or by `id` and `type` when none of them is set.
+func resolveEscalationPolicyTarget(ctx context.Context, client *api.Client, teamID string, mtarget tf.M) (*api.EscalationPolicyTarget, error) {
+	if email := mtarget["user_email"].(string); email != "" {
+		user, err := client.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("could not find a user with email `%s`: %s", email, err.Error())
+		}
+		if _, err := client.GetTeamMemberByID(ctx, teamID, user.ID); err != nil {
+			if api.IsResourceNotFoundError(err) {
+				return nil, fmt.Errorf("the user with email `%s` is not a member of team %s", email, teamID)
+			}
+			return nil, err
+		}
+		return &api.EscalationPolicyTarget{ID: user.ID, Type: "user"}, nil
+	}
+
+	if name := mtarget["squad_name"].(string); name != "" {
+		squad, err := client.GetSquadByName(ctx, teamID, name)
+		if err != nil {
+			return nil, fmt.Errorf("could not find a squad with name `%s` in team %s: %s", name, teamID, err.Error())
+		}
+		return &api.EscalationPolicyTarget{ID: squad.ID, Type: "squad"}, nil
+	}
+
+	if name := mtarget["schedule_name"].(string); name != "" {
+		schedule, err := client.GetScheduleByName(ctx, teamID, name)
+		if err != nil {
+			return nil, fmt.Errorf("could not find a schedule with name `%s` in team %s: %s", name, teamID, err.Error())
+		}
+		return &api.EscalationPolicyTarget{ID: schedule.ID, Type: "schedule"}, nil
+	}
+
+	if mtarget["id"].(string) == "" || mtarget["type"].(string) == "" {
+		return nil, fmt.Errorf("either `id` and `type`, or one of `user_email`, `squad_name` and `schedule_name` must be set")
+	}
+
+	return &api.EscalationPolicyTarget{
+		ID:   mtarget["id"].(string),
+		Type: mtarget["type"].(string),
+	}, nil
+}
+
+// validateEscalationPolicyTargets checks, using the configuration, that every target is set by exactly one of `id`, `user_email`, `squad_name` and `schedule_name`,
+// and resolves the targets set by email or name so that a missing user, squad or schedule is reported at plan time.
+func validateEscalationPolicyTargets(ctx context.Context, client *api.Client, teamID string, rules cty.Value) error {
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	for it := rules.ElementIterator(); it.Next(); {
+		i, rule := it.Element()
+		targets := rule.GetAttr("targets")
+		if !targets.IsKnown() || targets.IsNull() {
+			continue
+		}
+
+		for jt := targets.ElementIterator(); jt.Next(); {
+			j, target := jt.Element()
+			key := fmt.Sprintf("rules.%s.targets.%s", i.AsBigFloat().String(), j.AsBigFloat().String())
+
+			set := make([]string, 0)
+			for _, attr := range append([]string{"id"}, escalationPolicyTargetRefs...) {
+				if !target.GetAttr(attr).IsNull() {
+					set = append(set, "`"+attr+"`")
+				}
+			}
+
+			switch {
+			case len(set) == 0:
+				return fmt.Errorf("%s: one of `id`, `user_email`, `squad_name` or `schedule_name` must be set", key)
+			case len(set) > 1:
+				return fmt.Errorf("%s: only one of `id`, `user_email`, `squad_name` and `schedule_name` can be set, got %s", key, strings.Join(set, " and "))
+			case set[0] == "`id`":
+				if target.GetAttr("type").IsNull() {
+					return fmt.Errorf("%s: `type` is required when `id` is set", key)
+				}
+				continue
+			}
+
+			if teamID == "" {
+				continue
+			}
+
+			mtarget := tf.M{"id": "", "type": ""}
+			for _, attr := range escalationPolicyTargetRefs {
+				v := target.GetAttr(attr)
+				if !v.IsKnown() {
+					mtarget = nil
+					break
+				}
+				mtarget[attr] = ""
+				if !v.IsNull() {
+					mtarget[attr] = v.AsString()
+				}
+			}
+			if mtarget == nil {
+				continue
+			}
+
+			if _, err := resolveEscalationPolicyTarget(ctx, client, teamID, mtarget); err != nil {
+				return fmt.Errorf("%s: %s", key, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+// ctyBlock returns the block of a MaxItems 1 block list, when it is set and known.
+func ctyBlock(v cty.Value) (cty.Value, bool) {
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() != 1 {
+		return cty.NilVal, false
+	}
+	return v.Index(cty.NumberIntVal(0)), true
+}
+
+// ctyInt returns the value of a number attribute, when it is set and known.
+func ctyInt(v cty.Value) (int64, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return 0, false
+	}
+	i, _ := v.AsBigFloat().Int64()
+	return i, true
+}
+
+// ctyBool returns the value of a bool attribute, when it is set and known.
+func ctyBool(v cty.Value) (bool, bool) {
+	if !v.IsKnown() || v.IsNull() {
+		return false, false
+	}
+	return v.True(), true
+}
+
+// validateEscalationPolicyRepeat checks a `repeat` block, the API drops a repetition which is repeated 0 times.
+// maxTimes is ignored when it is 0.
+func validateEscalationPolicyRepeat(key string, repeat cty.Value, maxTimes int64) []string {
+	violations := make([]string, 0)
+
+	if times, ok := ctyInt(repeat.GetAttr("times")); ok {
+		if times < 1 {
+			violations = append(violations, fmt.Sprintf("%s.times: must be at least 1, got %d", key, times))
+		} else if maxTimes > 0 && times > maxTimes {
+			violations = append(violations, fmt.Sprintf("%s.times: must be at most %d, got %d", key, maxTimes, times))
+		}
+	}
+	if delay, ok := ctyInt(repeat.GetAttr("delay_minutes")); ok && delay < 0 {
+		violations = append(violations, fmt.Sprintf("%s.delay_minutes: must not be negative, got %d", key, delay))
+	}
+
+	return violations
+}
+
+// validateEscalationPolicy checks, using the configuration, the combinations of attributes which the API either rejects,
+// or silently rewrites which results in a perpetual diff. Unknown values are not checked.
+func validateEscalationPolicy(config cty.Value) []string {
+	violations := make([]string, 0)
+
+	if repeat, ok := ctyBlock(config.GetAttr("repeat")); ok {
+		violations = append(violations, validateEscalationPolicyRepeat("repeat.0", repeat, 3)...)
+	}
+
+	rules := config.GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return violations
+	}
+
+	for it := rules.ElementIterator(); it.Next(); {
+		idx, rule := it.Element()
+		key := "rules." + idx.AsBigFloat().String()
+
+		if delay, ok := ctyInt(rule.GetAttr("delay_minutes")); ok && delay < 0 {
+			violations = append(violations, fmt.Sprintf("%s.delay_minutes: must not be negative, got %d", key, delay))
+		}
+
+		repeat, hasRepeat := ctyBlock(rule.GetAttr("repeat"))
+		if hasRepeat {
+			violations = append(violations, validateEscalationPolicyRepeat(key+".repeat.0", repeat, 0)...)
+		}
+
+		if rr, ok := ctyBlock(rule.GetAttr("round_robin")); ok {
+			rrEnabled, rrKnown := ctyBool(rr.GetAttr("enabled"))
+			if rrKnown && !rrEnabled {
+				violations = append(violations, fmt.Sprintf("%s.round_robin.0.enabled: must be true, remove the round_robin block to disable round robin", key))
+			}
+
+			if rotation, ok := ctyBlock(rr.GetAttr("rotation")); ok {
+				enabled, known := ctyBool(rotation.GetAttr("enabled"))
+				// Rotation and repetition share the delay of the rule in the API, the repetition is dropped when rotation is enabled.
+				if known && enabled && hasRepeat {
+					violations = append(violations, fmt.Sprintf("%s.round_robin.0.rotation: cannot be used together with %s.repeat, please remove one", key, key))
+				}
+
+				switch {
+				case known && !enabled:
+					violations = append(violations, fmt.Sprintf("%s.round_robin.0.rotation.0.enabled: must be true, remove the rotation block to disable rotation", key))
+				case known && rrKnown && !rrEnabled:
+					violations = append(violations, fmt.Sprintf("%s.round_robin.0.rotation.0.enabled: rotation requires %s.round_robin.0.enabled to be true", key, key))
+				}
+			}
+		}
+
+		// An empty list of channels is only accepted for squads and schedules, users are notified
+		// according to their personal notification rules when notification_channels is not set.
+		channels := rule.GetAttr("notification_channels")
+		targets := rule.GetAttr("targets")
+		if channels.IsKnown() && !channels.IsNull() && channels.LengthInt() == 0 && targets.IsKnown() && !targets.IsNull() {
+			for jt := targets.ElementIterator(); jt.Next(); {
+				_, target := jt.Element()
+				targetType := target.GetAttr("type")
+				isUser := !target.GetAttr("user_email").IsNull() ||
+					(targetType.IsKnown() && !targetType.IsNull() && targetType.AsString() == "user")
+				if isUser {
+					violations = append(violations, fmt.Sprintf("%s.notification_channels: must not be empty when the rule targets a user, remove it to notify users according to their personal notification rules", key))
+					break
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+func resourceEscalationPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*api.Client)
+
+	if violations := validateEscalationPolicy(d.GetRawConfig()); len(violations) > 0 {
+		return fmt.Errorf("escalation policy `%s` is invalid:\n%s", d.Get("name").(string), strings.Join(violations, "\n"))
+	}
+
+	teamID := ""
+	if d.NewValueKnown("team_id") {
+		teamID = d.Get("team_id").(string)
+	}
+
+	return validateEscalationPolicyTargets(ctx, client, teamID, d.GetRawConfig().GetAttr("rules"))
+}
+
+func decodeEscalationPolicyRules(ctx context.Context, client *api.Client, teamID string, mrules []tf.M) ([]api.EscalationPolicyRule, error) {
 	rules := make([]api.EscalationPolicyRule, 0)
 
 	for i, mrule := range mrules {
@@ -213,18 +478,18 @@ func decodeEscalationPolicyRules(mrules []tf.M) ([]api.EscalationPolicyRule, err
 			}
 		}
 
-		if len(mrepeats) == 1 && rule.RoundrobinEnabled {
-			return nil, fmt.Errorf("rule %d cannot have both round robin and a repetition, please remove one", i)
+		if len(mrepeats) == 1 && rule.RoundrobinEnabled && rule.EscalateWithinRoundrobin {
+			return nil, fmt.Errorf("rule %d cannot have both round robin rotation and a repetition, please remove one", i)
 		}
 
 		mtargets := tf.ListToSlice[tf.M](mrule["targets"])
 		targets := make([]*api.EscalationPolicyTarget, 0)
-		for _, mtarget := range mtargets {
-			target := api.EscalationPolicyTarget{
-				ID:   mtarget["id"].(string),
-				Type: mtarget["type"].(string),
+		for j, mtarget := range mtargets {
+			target, err := resolveEscalationPolicyTarget(ctx, client, teamID, mtarget)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d target %d: %s", i, j, err.Error())
 			}
-			targets = append(targets, &target)
+			targets = append(targets, target)
 		}
 		rule.Targets = targets
 
@@ -236,8 +501,8 @@ func decodeEscalationPolicyRules(mrules []tf.M) ([]api.EscalationPolicyRule, err
 	return rules, nil
 }
 
-func decodeEscalationPolicy(d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyReq, error) {
-	rules, err := decodeEscalationPolicyRules(tf.ListToSlice[tf.M](d.Get("rules")))
+func decodeEscalationPolicy(ctx context.Context, client *api.Client, d *schema.ResourceData) (*api.CreateUpdateEscalationPolicyReq, error) {
+	rules, err := decodeEscalationPolicyRules(ctx, client, d.Get("team_id").(string), tf.ListToSlice[tf.M](d.Get("rules")))
 	if err != nil {
 		return nil, fmt.Errorf("escalation policy `%s` is invalid: %s", d.Get("name").(string), err.Error())
 	}
@@ -262,7 +527,7 @@ func resourceEscalationPolicyCreate(ctx context.Context, d *schema.ResourceData,
 		"name": d.Get("name").(string),
 	})
 
-	req, err := decodeEscalationPolicy(d)
+	req, err := decodeEscalationPolicy(ctx, client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -300,17 +565,47 @@ func resourceEscalationPolicyRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(escalationPolicy, d); err != nil {
+	m, err := escalationPolicy.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keepEscalationPolicyTargetRefs(tf.ListToSlice[tf.M](d.Get("rules")), m["rules"].([]any))
+
+	if err = tf.SetState(d, m); err != nil {
 		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
+// keepEscalationPolicyTargetRefs copies `user_email`, `squad_name` and `schedule_name` from the targets in the state
+// to the targets read from the API, as long as they still have the id and type stored in the state.
+func keepEscalationPolicyTargetRefs(prevRules []tf.M, rules []any) {
+	for i, rule := range rules {
+		if i >= len(prevRules) {
+			return
+		}
+		prevTargets := tf.ListToSlice[tf.M](prevRules[i]["targets"])
+
+		for j, target := range rule.(tf.M)["targets"].([]any) {
+			if j >= len(prevTargets) {
+				break
+			}
+			mtarget, prev := target.(tf.M), prevTargets[j]
+			if prev["id"] != mtarget["id"] || prev["type"] != mtarget["type"] {
+				continue
+			}
+			for _, attr := range escalationPolicyTargetRefs {
+				mtarget[attr] = prev[attr]
+			}
+		}
+	}
+}
+
 func resourceEscalationPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	req, err := decodeEscalationPolicy(d)
+	req, err := decodeEscalationPolicy(ctx, client, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
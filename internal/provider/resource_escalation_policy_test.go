@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -300,3 +301,149 @@ resource "squadcast_escalation_policy" "test" {
 }
 	`, escalationPolicyName)
 }
+
+func TestAccResourceEscalationPolicy_targetRefs(t *testing.T) {
+	escalationPolicyName := acctest.RandomWithPrefix("escalationpolicy")
+	squadName := acctest.RandomWithPrefix("squad")
+
+	resourceName := "squadcast_escalation_policy.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckEscalationPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceEscalationPolicyConfig_targetRefs(escalationPolicyName, squadName, "squad_name = \"does-not-exist\""),
+				ExpectError: regexp.MustCompile("could not find a squad with name `does-not-exist`"),
+			},
+			{
+				Config:      testAccResourceEscalationPolicyConfig_targetRefs(escalationPolicyName, squadName, "id = \"5f8891527f735f0a6646f3b6\"\n\t\t\tuser_email = \"dheeraj@squadcast.com\""),
+				ExpectError: regexp.MustCompile("only one of `id`, `user_email`, `squad_name` and `schedule_name` can be set"),
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_targetRefs(escalationPolicyName, squadName, "squad_name = squadcast_squad.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.user_email", "dheeraj@squadcast.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.targets.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.0.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.1.squad_name", squadName),
+					resource.TestCheckResourceAttrPair(resourceName, "rules.0.targets.1.id", "squadcast_squad.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.targets.1.type", "squad"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEscalationPolicyConfig_targetRefs(escalationPolicyName, squadName, squadTarget string) string {
+	return fmt.Sprintf(`
+resource "squadcast_squad" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	member_ids = ["5f8891527f735f0a6646f3b6"]
+}
+
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+
+	rules {
+		delay_minutes = 0
+
+		targets {
+			user_email = "dheeraj@squadcast.com"
+		}
+
+		targets {
+			%s
+		}
+	}
+}
+	`, squadName, escalationPolicyName, squadTarget)
+}
+
+func TestAccResourceEscalationPolicy_invalidRules(t *testing.T) {
+	escalationPolicyName := acctest.RandomWithPrefix("escalationpolicy")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, `
+	repeat {
+		times = 4
+		delay_minutes = 10
+	}`, ``),
+				ExpectError: regexp.MustCompile(`repeat.0.times: must be at most 3, got 4`),
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, ``, `
+		repeat {
+			times = 1
+			delay_minutes = 5
+		}
+
+		round_robin {
+			enabled = true
+
+			rotation {
+				enabled = true
+				delay_minutes = 1
+			}
+		}`),
+				ExpectError: regexp.MustCompile(`rules.0.round_robin.0.rotation: cannot be used together with rules.0.repeat`),
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, ``, `
+		repeat {
+			times = 1
+			delay_minutes = 5
+		}
+
+		round_robin {
+			enabled = true
+		}`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, ``, `
+		round_robin {
+			enabled = false
+
+			rotation {
+				enabled = true
+				delay_minutes = 1
+			}
+		}`),
+				ExpectError: regexp.MustCompile(`rules.0.round_robin.0.rotation.0.enabled: rotation requires rules.0.round_robin.0.enabled to be true`),
+			},
+			{
+				Config: testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, ``, `
+		notification_channels = []`),
+				ExpectError: regexp.MustCompile(`rules.0.notification_channels: must not be empty when the rule targets a user`),
+			},
+		},
+	})
+}
+
+func testAccResourceEscalationPolicyConfig_invalidRules(escalationPolicyName, policyBlocks, ruleBlocks string) string {
+	return fmt.Sprintf(`
+resource "squadcast_escalation_policy" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+%s
+	rules {
+		delay_minutes = 0
+
+		targets {
+			id = "5f8891527f735f0a6646f3b6"
+			type = "user"
+		}
+%s
+	}
+}
+	`, escalationPolicyName, policyBlocks, ruleBlocks)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceGlobalEventRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Global event rule routes the alerts matching its expression to a service. Rules of a `squadcast_global_event_ruleset` are evaluated in order and the first matching rule wins.",
+
+		CreateContext: resourceGlobalEventRuleCreate,
+		ReadContext:   resourceGlobalEventRuleRead,
+		UpdateContext: resourceGlobalEventRuleUpdate,
+		DeleteContext: resourceGlobalEventRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGlobalEventRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Global event rule id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ruleset_id": {
+				Description:  "Global event ruleset id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ForceNew:     true,
+			},
+			"description": {
+				Description:  "Detailed description about the rule.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"expression": {
+				Description:      "The expression which is evaluated against the alert payload, e.g. `payload[\"source\"] == \"prometheus\"`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tf.AllDiag(validation.ToDiagFunc(validation.StringIsNotWhiteSpace), validateExpression(expression.PayloadRoots...)),
+			},
+			"route_to": {
+				Description:  "Id of the `squadcast_service` to which matching alerts are routed.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"position": {
+				Description: "Zero-based position of the rule in the evaluation order of the ruleset. New rules are appended to the end of the ruleset when this is not set. " +
+					"The rule is moved to the end of the ruleset when there are less rules than its position.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func parseGlobalEventRuleImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of import resource id (%s), expected rulesetID:ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceGlobalEventRuleImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	rulesetID, id, err := parseGlobalEventRuleImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("ruleset_id", rulesetID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeGlobalEventRule(d *schema.ResourceData) *api.CreateUpdateGlobalEventRuleReq {
+	return &api.CreateUpdateGlobalEventRuleReq{
+		Description: d.Get("description").(string),
+		Expression:  d.Get("expression").(string),
+		Action: api.GlobalEventRuleAction{
+			RouteTo: d.Get("route_to").(string),
+		},
+	}
+}
+
+// moveGlobalEventRule moves the rule to the given position in the evaluation order of the ruleset,
+// shifting the rules after it down by one.
+func moveGlobalEventRule(ctx context.Context, client *api.Client, rulesetID string, id string, position int) error {
+	rules, err := client.ListGlobalEventRules(ctx, rulesetID)
+	if err != nil {
+		return err
+	}
+
+	ordering := make([]uint, 0, len(rules))
+	var ruleID uint
+	for _, rule := range rules {
+		if fmt.Sprint(rule.ID) == id {
+			if rule.Position == position {
+				return nil
+			}
+			ruleID = rule.ID
+			continue
+		}
+		ordering = append(ordering, rule.ID)
+	}
+	if ruleID == 0 {
+		return fmt.Errorf("could not find global event rule with the id: %s", id)
+	}
+
+	if position > len(ordering) {
+		position = len(ordering)
+	}
+	ordering = append(ordering[:position], append([]uint{ruleID}, ordering[position:]...)...)
+
+	tflog.Info(ctx, "Updating global_event_rule position", tf.M{
+		"id":       id,
+		"position": position,
+	})
+	_, err = client.UpdateGlobalEventRulesOrdering(ctx, rulesetID, &api.UpdateGlobalEventRulesOrderingReq{
+		Ordering: ordering,
+	})
+	return err
+}
+
+func resourceGlobalEventRuleCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Info(ctx, "Creating global_event_rule", tf.M{
+		"ruleset_id": rulesetID,
+	})
+	rule, err := client.CreateGlobalEventRule(ctx, rulesetID, decodeGlobalEventRule(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(rule.ID), 10))
+
+	if !d.GetRawConfig().GetAttr("position").IsNull() {
+		if err := moveGlobalEventRule(ctx, client, rulesetID, d.Id(), d.Get("position").(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGlobalEventRuleRead(ctx, d, meta)
+}
+
+func resourceGlobalEventRuleRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	rulesetID, ok := d.GetOk("ruleset_id")
+	if !ok {
+		return diag.Errorf("invalid ruleset id provided")
+	}
+
+	tflog.Info(ctx, "Reading global_event_rule", tf.M{
+		"id":         d.Id(),
+		"ruleset_id": rulesetID.(string),
+	})
+	rule, err := client.GetGlobalEventRuleById(ctx, rulesetID.(string), id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	position := d.Get("position").(int)
+	if err = tf.EncodeAndSet(rule, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A rule whose position is beyond the end of the ruleset is the last rule.
+	if position > rule.Position {
+		rules, err := client.ListGlobalEventRules(ctx, rulesetID.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if rule.Position == len(rules)-1 {
+			d.Set("position", position)
+		}
+	}
+
+	return nil
+}
+
+func resourceGlobalEventRuleUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rulesetID := d.Get("ruleset_id").(string)
+
+	if d.HasChanges("description", "expression", "route_to") {
+		_, err := client.UpdateGlobalEventRule(ctx, rulesetID, d.Id(), decodeGlobalEventRule(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("position") && !d.GetRawConfig().GetAttr("position").IsNull() {
+		if err := moveGlobalEventRule(ctx, client, rulesetID, d.Id(), d.Get("position").(int)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGlobalEventRuleRead(ctx, d, meta)
+}
+
+func resourceGlobalEventRuleDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteGlobalEventRule(ctx, d.Get("ruleset_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
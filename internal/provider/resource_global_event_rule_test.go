@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceGlobalEventRule(t *testing.T) {
+	rulesetName := acctest.RandomWithPrefix("ger")
+
+	resourceName := "squadcast_global_event_rule.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGlobalEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGlobalEventRuleConfig(rulesetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ruleset_id", "squadcast_global_event_ruleset.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_to", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "expression", "payload[\"source\"] == \"prometheus\""),
+					resource.TestCheckResourceAttr(resourceName, "position", "0"),
+				),
+			},
+			{
+				Config: testAccResourceGlobalEventRuleConfig_update(rulesetName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ruleset_id", "squadcast_global_event_ruleset.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_to", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
+					resource.TestCheckResourceAttr(resourceName, "expression", "payload[\"source\"] == \"grafana\""),
+					resource.TestCheckResourceAttr(resourceName, "position", "0"),
+					resource.TestCheckResourceAttr("squadcast_global_event_rule.other", "position", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("cannot find %s in the state", resourceName)
+					}
+
+					return rs.Primary.Attributes["ruleset_id"] + ":" + rs.Primary.ID, nil
+				},
+			},
+			{
+				Config: testAccResourceGlobalEventRuleConfig_update(rulesetName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "position", "5"),
+					resource.TestCheckResourceAttr("squadcast_global_event_rule.other", "position", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalEventRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_global_event_rule" {
+			continue
+		}
+
+		_, err := client.GetGlobalEventRuleById(context.Background(), rs.Primary.Attributes["ruleset_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected global event rule to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceGlobalEventRuleConfig(rulesetName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_global_event_ruleset" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+}
+
+resource "squadcast_global_event_rule" "test" {
+	ruleset_id = squadcast_global_event_ruleset.test.id
+	expression = "payload[\"source\"] == \"prometheus\""
+	route_to = squadcast_service.test.id
+}
+	`, rulesetName, rulesetName, rulesetName)
+}
+
+func testAccResourceGlobalEventRuleConfig_update(rulesetName string, position int) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_global_event_ruleset" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+}
+
+resource "squadcast_global_event_rule" "other" {
+	ruleset_id = squadcast_global_event_ruleset.test.id
+	expression = "payload[\"source\"] == \"prometheus\""
+	route_to = squadcast_service.test.id
+}
+
+resource "squadcast_global_event_rule" "test" {
+	ruleset_id = squadcast_global_event_ruleset.test.id
+	description = "some description here."
+	expression = "payload[\"source\"] == \"grafana\""
+	route_to = squadcast_service.test.id
+	position = %d
+
+	depends_on = [squadcast_global_event_rule.other]
+}
+	`, rulesetName, rulesetName, rulesetName, position)
+}
@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceGlobalEventRuleset() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Global Event Rules](https://support.squadcast.com/docs/global-event-rules) route alerts sent to a central ingestion endpoint to the right service, based on the alert payload, before the service's own rules are applied. Use `squadcast_global_event_rule` to add rules to the ruleset.",
+
+		CreateContext: resourceGlobalEventRulesetCreate,
+		ReadContext:   resourceGlobalEventRulesetRead,
+		UpdateContext: resourceGlobalEventRulesetUpdate,
+		DeleteContext: resourceGlobalEventRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGlobalEventRulesetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Global event ruleset id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Global event ruleset.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the Global event ruleset.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"entity_owner": {
+				Description: "User or squad that owns the ruleset.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Owner type. Supported values are \"user\" and \"squad\"",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"user", "squad"}, false),
+						},
+						"id": {
+							Description:  "Owner id.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateObjectID,
+						},
+					},
+				},
+			},
+			"catch_all_route_to": {
+				Description:  "Id of the `squadcast_service` to which alerts that do not match any rule are routed. Unmatched alerts are dropped when this is not set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+			"routing_key": {
+				Description: "Routing key of the ruleset.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ingestion_endpoint": {
+				Description: "URL to which alerts have to be sent for them to be evaluated by the ruleset.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceGlobalEventRulesetImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeGlobalEventRulesetEntityOwner(d *schema.ResourceData) *api.EntityOwner {
+	owner := tf.ListToSlice[tf.M](d.Get("entity_owner"))
+	if len(owner) == 0 {
+		return nil
+	}
+
+	return &api.EntityOwner{
+		Type: owner[0]["type"].(string),
+		ID:   owner[0]["id"].(string),
+	}
+}
+
+func decodeGlobalEventRulesetCatchAllAction(d *schema.ResourceData) *api.GlobalEventRuleAction {
+	routeTo := d.Get("catch_all_route_to").(string)
+	if routeTo == "" {
+		return nil
+	}
+
+	return &api.GlobalEventRuleAction{RouteTo: routeTo}
+}
+
+func resourceGlobalEventRulesetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating global_event_ruleset", tf.M{
+		"name": d.Get("name").(string),
+	})
+	ruleset, err := client.CreateGlobalEventRuleset(ctx, &api.CreateGlobalEventRulesetReq{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		OwnerID:        d.Get("team_id").(string),
+		OwnerType:      "team",
+		EntityOwner:    decodeGlobalEventRulesetEntityOwner(d),
+		CatchAllAction: decodeGlobalEventRulesetCatchAllAction(d),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(ruleset.ID), 10))
+
+	return resourceGlobalEventRulesetRead(ctx, d, meta)
+}
+
+func resourceGlobalEventRulesetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	tflog.Info(ctx, "Reading global_event_ruleset", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	ruleset, err := client.GetGlobalEventRulesetById(ctx, id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	ruleset.IngestionEndpoint = ruleset.Endpoint(client.IngestionBaseURL)
+
+	if err = tf.EncodeAndSet(ruleset, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGlobalEventRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateGlobalEventRuleset(ctx, d.Id(), &api.UpdateGlobalEventRulesetReq{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		EntityOwner:    decodeGlobalEventRulesetEntityOwner(d),
+		CatchAllAction: decodeGlobalEventRulesetCatchAllAction(d),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGlobalEventRulesetRead(ctx, d, meta)
+}
+
+func resourceGlobalEventRulesetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteGlobalEventRuleset(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceGlobalEventRuleset(t *testing.T) {
+	rulesetName := acctest.RandomWithPrefix("ger")
+
+	resourceName := "squadcast_global_event_ruleset.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckGlobalEventRulesetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceGlobalEventRulesetConfig(rulesetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rulesetName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "entity_owner.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "catch_all_route_to", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingestion_endpoint"),
+				),
+			},
+			{
+				Config: testAccResourceGlobalEventRulesetConfig_update(rulesetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rulesetName),
+					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "entity_owner.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "entity_owner.0.id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttrPair(resourceName, "catch_all_route_to", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingestion_endpoint"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "613611c1eb22db455cfa789f:",
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalEventRulesetDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_global_event_ruleset" {
+			continue
+		}
+
+		_, err := client.GetGlobalEventRulesetById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected global event ruleset to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceGlobalEventRulesetConfig(rulesetName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_global_event_ruleset" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+}
+	`, rulesetName)
+}
+
+func testAccResourceGlobalEventRulesetConfig_update(rulesetName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_global_event_ruleset" "test" {
+	name = "%s"
+	description = "some description here."
+	team_id = "613611c1eb22db455cfa789f"
+
+	entity_owner {
+		type = "user"
+		id = "5f8891527f735f0a6646f3b6"
+	}
+
+	catch_all_route_to = squadcast_service.test.id
+}
+	`, rulesetName, rulesetName, rulesetName)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// routingServiceRule describes the routing rules of a service, see serviceRule.
+func routingServiceRule() *serviceRule[*api.RoutingRule] {
+	return &serviceRule[*api.RoutingRule]{
+		kind: "routing_rules",
+		rule: resourceRoutingRules().Schema["rules"].Elem.(*schema.Resource).Schema,
+		refs: routeToRefs,
+		decode: func(ctx context.Context, client *api.Client, teamID string, m tf.M) (*api.RoutingRule, error) {
+			rules, err := decodeRoutingRules(ctx, client, teamID, []any{m})
+			if err != nil {
+				return nil, err
+			}
+			return &rules[0], nil
+		},
+		list: func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]*api.RoutingRule, error) {
+			routingRules, err := client.GetRoutingRules(ctx, serviceID, teamID)
+			if err != nil {
+				return nil, err
+			}
+			return routingRules.Rules, nil
+		},
+		update: func(ctx context.Context, client *api.Client, serviceID, teamID string, rules []*api.RoutingRule) error {
+			req := &api.UpdateRoutingRulesReq{Rules: make([]api.RoutingRule, len(rules))}
+			for i, rule := range rules {
+				req.Rules[i] = *rule
+			}
+			_, err := client.UpdateRoutingRules(ctx, serviceID, teamID, req)
+			return err
+		},
+	}
+}
+
+func resourceRoutingRule() *schema.Resource {
+	r := routingServiceRule().resource("A routing rule of a service. Unlike `squadcast_routing_rules`, which manages all the routing rules of a service, this resource only manages one rule and keeps the other rules of the service, so several modules can add rules to the same service. " +
+		"Rules do not have an id, the rule is found in the rules of the service by its content: a rule changed outside of Terraform is considered removed, and a rule identical to another rule of the service cannot be created. Must not be used together with `squadcast_routing_rules` for the same service.")
+	r.CustomizeDiff = customdiff.All(r.CustomizeDiff, resourceRoutingRuleCustomizeDiff)
+
+	return r
+}
+
+func resourceRoutingRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*api.Client)
+
+	teamID := ""
+	if d.NewValueKnown("team_id") {
+		teamID = d.Get("team_id").(string)
+	}
+
+	return validateRoutingRuleTarget(ctx, client, teamID, d.GetRawConfig(), "")
+}
@@ -2,17 +2,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-const routingRulesID = "routing_rules"
-
 func resourceRoutingRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Routing rules](https://support.squadcast.com/docs/alert-routing) allows you to ensure that alerts are routed to the right responder with the help of `event tags` attached to them.",
@@ -24,6 +26,7 @@ func resourceRoutingRules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceRoutingRulesImport,
 		},
+		CustomizeDiff: resourceRoutingRulesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -45,6 +48,8 @@ func resourceRoutingRules() *schema.Resource {
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
+			"on_existing": onExistingSchema("routing_rules"),
+			"rules_hash":  rulesHashSchema(),
 			"rules": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -56,21 +61,41 @@ func resourceRoutingRules() *schema.Resource {
 							Required:    true,
 						},
 						"expression": {
-							Description: "expression.",
+							Description:      "expression.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateExpression(expression.PayloadRoots...),
+						},
+						"compiled_expression": {
+							Description: "The advanced expression which is equivalent to the rule, compiled from `basic_expressions` when `is_basic` is true.",
 							Type:        schema.TypeString,
-							Optional:    true,
+							Computed:    true,
 						},
 						"route_to_id": {
-							Description:  "The id of the entity (user, escalation policy, squad) for which we are routing this incident.",
+							Description:  "The id of the entity (user, escalation policy, squad or schedule) for which we are routing this incident. Requires `route_to_type`. Conflicts with `route_to_name` and `route_to_email`, it is computed when one of them is set.",
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
+							Computed:     true,
 							ValidateFunc: tf.ValidateObjectID,
 						},
 						"route_to_type": {
-							Description:  "Type of the entity for which we are routing this incident - User, Escalation Policy or Squad",
+							Description:  "Type of the entity for which we are routing this incident, one of `user`, `escalationpolicy`, `squad` or `schedule`. Required with `route_to_id` and `route_to_name`, it is computed when `route_to_email` is set.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(routeToTypes, false),
+						},
+						"route_to_name": {
+							Description:  "Name of the escalation policy, squad or schedule for which we are routing this incident, within the team of the service. Requires `route_to_type`. The name is resolved to `route_to_id` when the rule is created or updated, renaming the target afterwards does not change the rule.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"route_to_email": {
+							Description:  "Email of the user for which we are routing this incident. The email is resolved to `route_to_id` when the rule is created or updated.",
 							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"user", "escalationpolicy", "squad"}, false),
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
 						},
 						"basic_expressions": {
 							Description: "basic expression.",
@@ -107,31 +132,256 @@ func resourceRoutingRulesImport(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("team_id", teamID)
 	d.Set("service_id", serviceID)
-	d.SetId(routingRulesID)
+	d.Set("on_existing", onExistingOverwrite)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return []*schema.ResourceData{d}, nil
 }
 
+// routeToTypes are the types of the entities a routing rule can route to.
+var routeToTypes = []string{"user", "escalationpolicy", "squad", "schedule"}
+
+// routeToRefs are the attributes which reference the target of a routing rule by name or email, instead of `route_to_id`.
+var routeToRefs = []string{"route_to_name", "route_to_email"}
+
+// resolveRouteTo returns the target of a routing rule referenced by `route_to_email`, or by `route_to_type` and `route_to_name`,
+// or by `route_to_type` and `route_to_id` when none of them is set.
+func resolveRouteTo(ctx context.Context, client *api.Client, teamID string, mrule tf.M) (*api.RouteTo, error) {
+	entityType, _ := mrule["route_to_type"].(string)
+
+	if email, _ := mrule["route_to_email"].(string); email != "" {
+		user, err := client.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, fmt.Errorf("could not find a user with email `%s`: %s", email, err.Error())
+		}
+		return &api.RouteTo{EntityID: user.ID, EntityType: "user"}, nil
+	}
+
+	if name, _ := mrule["route_to_name"].(string); name != "" {
+		if teamID == "" {
+			return nil, fmt.Errorf("`route_to_name` requires the team id of the service")
+		}
+
+		var id string
+		switch entityType {
+		case "escalationpolicy":
+			escalationPolicy, err := client.GetEscalationPolicyByName(ctx, teamID, name)
+			if err != nil {
+				return nil, fmt.Errorf("could not find an escalation policy with name `%s` in team %s: %s", name, teamID, err.Error())
+			}
+			id = escalationPolicy.ID
+		case "squad":
+			squad, err := client.GetSquadByName(ctx, teamID, name)
+			if err != nil {
+				return nil, fmt.Errorf("could not find a squad with name `%s` in team %s: %s", name, teamID, err.Error())
+			}
+			id = squad.ID
+		case "schedule":
+			schedule, err := client.GetScheduleByName(ctx, teamID, name)
+			if err != nil {
+				return nil, fmt.Errorf("could not find a schedule with name `%s` in team %s: %s", name, teamID, err.Error())
+			}
+			id = schedule.ID
+		case "user":
+			return nil, fmt.Errorf("users cannot be referenced by name, use `route_to_email` instead")
+		default:
+			return nil, fmt.Errorf("`route_to_type` is required with `route_to_name`")
+		}
+		return &api.RouteTo{EntityID: id, EntityType: entityType}, nil
+	}
+
+	id, _ := mrule["route_to_id"].(string)
+	if id == "" || entityType == "" {
+		return nil, fmt.Errorf("either `route_to_id` and `route_to_type`, or `route_to_email`, or `route_to_type` and `route_to_name` must be set")
+	}
+	return &api.RouteTo{EntityID: id, EntityType: entityType}, nil
+}
+
+// checkRouteTo checks that the target of a routing rule belongs to the team of the service.
+func checkRouteTo(ctx context.Context, client *api.Client, teamID string, routeTo *api.RouteTo) error {
+	var owner api.OwnerRef
+	switch routeTo.EntityType {
+	case "user":
+		if _, err := client.GetTeamMemberByID(ctx, teamID, routeTo.EntityID); err != nil {
+			if api.IsResourceNotFoundError(err) {
+				return fmt.Errorf("user %s is not a member of team %s", routeTo.EntityID, teamID)
+			}
+			return err
+		}
+		return nil
+	case "escalationpolicy":
+		escalationPolicy, err := client.GetEscalationPolicyById(ctx, teamID, routeTo.EntityID)
+		if err != nil {
+			return fmt.Errorf("could not find the escalation policy %s in team %s: %s", routeTo.EntityID, teamID, err.Error())
+		}
+		owner = escalationPolicy.Owner
+	case "squad":
+		squad, err := client.GetSquadById(ctx, teamID, routeTo.EntityID)
+		if err != nil {
+			return fmt.Errorf("could not find the squad %s in team %s: %s", routeTo.EntityID, teamID, err.Error())
+		}
+		owner = squad.Owner
+	case "schedule":
+		schedule, err := client.GetScheduleById(ctx, teamID, routeTo.EntityID)
+		if err != nil {
+			return fmt.Errorf("could not find the schedule %s in team %s: %s", routeTo.EntityID, teamID, err.Error())
+		}
+		owner = schedule.Owner
+	default:
+		return nil
+	}
+
+	if owner.ID != "" && owner.ID != teamID {
+		return fmt.Errorf("%s %s belongs to team %s, not to the team of the service %s", routeTo.EntityType, routeTo.EntityID, owner.ID, teamID)
+	}
+	return nil
+}
+
+// decodeRoutingRules decodes routing rules, resolving the targets referenced by name or email.
+func decodeRoutingRules(ctx context.Context, client *api.Client, teamID string, input []any) ([]api.RoutingRule, error) {
+	var rules []api.RoutingRule
+	if err := Decode(input, &rules); err != nil {
+		return nil, err
+	}
+
+	for i, mrule := range input {
+		routeTo, err := resolveRouteTo(ctx, client, teamID, mrule.(tf.M))
+		if err != nil {
+			return nil, fmt.Errorf("routing rule %d: %s", i, err.Error())
+		}
+		rules[i].RouteTo = *routeTo
+	}
+
+	return rules, nil
+}
+
+// keepRouteToRefs copies `route_to_name` and `route_to_email` from the rules in the state to the rules read from the API,
+// as long as they still route to the same target.
+func keepRouteToRefs(prevRules []tf.M, rules []any) {
+	for i, rule := range rules {
+		if i >= len(prevRules) {
+			return
+		}
+		mrule, prev := rule.(tf.M), prevRules[i]
+		if prev["route_to_id"] != mrule["route_to_id"] || prev["route_to_type"] != mrule["route_to_type"] {
+			continue
+		}
+		for _, attr := range routeToRefs {
+			mrule[attr] = prev[attr]
+		}
+	}
+}
+
+// validateRoutingRuleTarget checks, using the configuration, that the target of a routing rule is set either by id, by name or by email,
+// and that it belongs to the team of the service, so that a missing target or a target of another team is reported at plan time.
+func validateRoutingRuleTarget(ctx context.Context, client *api.Client, teamID string, rule cty.Value, prefix string) error {
+	set := make([]string, 0)
+	for _, attr := range append([]string{"route_to_id"}, routeToRefs...) {
+		if !rule.GetAttr(attr).IsNull() {
+			set = append(set, "`"+attr+"`")
+		}
+	}
+
+	entityType := rule.GetAttr("route_to_type")
+	switch {
+	case len(set) == 0:
+		return fmt.Errorf("%sone of `route_to_id`, `route_to_name` or `route_to_email` must be set", prefix)
+	case len(set) > 1:
+		return fmt.Errorf("%sonly one of `route_to_id`, `route_to_name` and `route_to_email` can be set, got %s", prefix, strings.Join(set, " and "))
+	case set[0] != "`route_to_email`" && entityType.IsNull():
+		return fmt.Errorf("%s`route_to_type` is required with %s", prefix, set[0])
+	case set[0] == "`route_to_email`" && !entityType.IsNull() && entityType.IsKnown() && entityType.AsString() != "user":
+		return fmt.Errorf("%s`route_to_email` routes to a user, `route_to_type` must be `user` or not set", prefix)
+	}
+
+	if teamID == "" {
+		return nil
+	}
+
+	mrule := tf.M{}
+	for _, attr := range append([]string{"route_to_id", "route_to_type"}, routeToRefs...) {
+		v := rule.GetAttr(attr)
+		if !v.IsKnown() {
+			return nil
+		}
+		mrule[attr] = ""
+		if !v.IsNull() {
+			mrule[attr] = v.AsString()
+		}
+	}
+
+	routeTo, err := resolveRouteTo(ctx, client, teamID, mrule)
+	if err == nil {
+		err = checkRouteTo(ctx, client, teamID, routeTo)
+	}
+	if err != nil {
+		return fmt.Errorf("%s%s", prefix, err.Error())
+	}
+	return nil
+}
+
+func resourceRoutingRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*api.Client)
+
+	if err := validateRulesBasicExpressions(d); err != nil {
+		return err
+	}
+
+	rules := d.GetRawConfig().GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	teamID := ""
+	if d.NewValueKnown("team_id") {
+		teamID = d.Get("team_id").(string)
+	}
+
+	for i, rule := range rules.AsValueSlice() {
+		if err := validateRoutingRuleTarget(ctx, client, teamID, rule, fmt.Sprintf("rules.%d: ", i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceRoutingRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var rules []api.RoutingRule
-	err := Decode(d.Get("rules"), &rules)
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	rules, err := decodeRoutingRules(ctx, client, teamID, d.Get("rules").([]any))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	tflog.Info(ctx, "Creating routing_rules", tf.M{
-		"team_id":    d.Get("team_id").(string),
-		"service_id": d.Get("service_id").(string),
-	})
+	unlock := lockServiceRules("routing_rules", serviceID)
+	defer unlock()
 
-	_, err = client.UpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateRoutingRulesReq{Rules: rules})
+	existing, err := client.GetRoutingRules(ctx, serviceID, teamID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	adopt, err := adoptServiceRules(ctx, d, "routing_rules", len(existing.Rules))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !adopt {
+		tflog.Info(ctx, "Creating routing_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+
+		_, err = client.UpdateRoutingRules(ctx, serviceID, teamID, &api.UpdateRoutingRulesReq{Rules: rules})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
-	d.SetId(routingRulesID)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return resourceRoutingRulesRead(ctx, d, meta)
 }
@@ -159,7 +409,23 @@ func resourceRoutingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(routingRules, d); err != nil {
+	m, err := routingRules.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keepRouteToRefs(tf.ListToSlice[tf.M](d.Get("rules")), m["rules"].([]any))
+
+	if err = tf.SetState(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	// The rules have the id of their document in the API, the resource is identified by its service instead.
+	d.SetId(serviceRulesID(teamID.(string), serviceID.(string)))
+
+	rulesHash, err := serviceRulesHash(routingRules.Rules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rules_hash", rulesHash); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -169,18 +435,12 @@ func resourceRoutingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceRoutingRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var rules []api.RoutingRule
-	err := Decode(d.Get("rules"), &rules)
-	if err != nil {
-		return diag.FromErr(err)
+	diags := routingServiceRule().updateRules(ctx, client, d)
+	if diags.HasError() {
+		return diags
 	}
 
-	_, err = client.UpdateRoutingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateRoutingRulesReq{Rules: rules})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return resourceRoutingRulesRead(ctx, d, meta)
+	return append(diags, resourceRoutingRulesRead(ctx, d, meta)...)
 }
 
 func resourceRoutingRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
@@ -26,6 +28,7 @@ func TestAccResourceRoutingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_id", "5f8891527f735f0a6646f3b6"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_type", "user"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.compiled_expression", "payload[\"event_id\"] == 40"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
@@ -45,6 +48,7 @@ func TestAccResourceRoutingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.lhs", "payload[\"foo\"]"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.rhs", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.compiled_expression", "payload[\"foo\"] == \"bar\""),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.route_to_id", "5f8c4ff09b0ccd917237c04b"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.route_to_type", "escalationpolicy"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
@@ -61,6 +65,91 @@ func TestAccResourceRoutingRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceRoutingRules_invalidExpression(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRoutingRulesConfig_expression(`payload[\"event_id\"] = 40`),
+				ExpectError: regexp.MustCompile(`column 21: unexpected character "=", did you mean "=="\?`),
+			},
+			{
+				Config:      testAccResourceRoutingRulesConfig_expression(`past.payload.host == \"web\"`),
+				ExpectError: regexp.MustCompile("column 1: unknown identifier `past`"),
+			},
+		},
+	})
+}
+
+func TestAccResourceRoutingRules_routeToRefs(t *testing.T) {
+	squadName := acctest.RandomWithPrefix("squad")
+
+	resourceName := "squadcast_routing_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckRoutingRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceRoutingRulesConfig_routeToRefs(squadName, "route_to_type = \"squad\"\n\t\troute_to_name = \"does-not-exist\""),
+				ExpectError: regexp.MustCompile("rules.1: could not find a squad with name `does-not-exist`"),
+			},
+			{
+				Config:      testAccResourceRoutingRulesConfig_routeToRefs(squadName, "route_to_id = \"5f8891527f735f0a6646f3b6\"\n\t\troute_to_email = \"dheeraj@squadcast.com\""),
+				ExpectError: regexp.MustCompile("rules.1: only one of `route_to_id`, `route_to_name` and `route_to_email` can be set"),
+			},
+			{
+				Config:      testAccResourceRoutingRulesConfig_routeToRefs(squadName, "route_to_id = \"5f8891527f735f0a6646f3b6\""),
+				ExpectError: regexp.MustCompile("rules.1: `route_to_type` is required with `route_to_id`"),
+			},
+			{
+				Config:      testAccResourceRoutingRulesConfig_routeToRefs(squadName, "route_to_type = \"escalation_policy\"\n\t\troute_to_id = \"5f8c4ff09b0ccd917237c04b\""),
+				ExpectError: regexp.MustCompile(`expected rules.1.route_to_type to be one of`),
+			},
+			{
+				Config: testAccResourceRoutingRulesConfig_routeToRefs(squadName, "route_to_type = \"squad\"\n\t\troute_to_name = squadcast_squad.test.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_email", "dheeraj@squadcast.com"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_id", "5f8891527f735f0a6646f3b6"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route_to_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.route_to_name", squadName),
+					resource.TestCheckResourceAttrPair(resourceName, "rules.1.route_to_id", "squadcast_squad.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.route_to_type", "squad"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRoutingRulesConfig_routeToRefs(squadName, squadRouteTo string) string {
+	return fmt.Sprintf(`
+resource "squadcast_squad" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	member_ids = ["5f8891527f735f0a6646f3b6"]
+}
+
+resource "squadcast_routing_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 40"
+		route_to_email = "dheeraj@squadcast.com"
+	}
+
+	rules {
+		is_basic = false
+		expression = "payload[\"event_id\"] == 41"
+		%s
+	}
+}
+	`, squadName, squadRouteTo)
+}
+
 func testAccCheckRoutingRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -126,3 +215,19 @@ resource "squadcast_routing_rules" "test" {
 }
 	`)
 }
+
+func testAccResourceRoutingRulesConfig_expression(expression string) string {
+	return fmt.Sprintf(`
+resource "squadcast_routing_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "%s"
+		route_to_id = "5f8891527f735f0a6646f3b6"
+		route_to_type = "user"
+	}
+}
+	`, expression)
+}
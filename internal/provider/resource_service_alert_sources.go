@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceServiceAlertSources() *schema.Resource {
+	return &schema.Resource{
+		Description: "`squadcast_service_alert_sources` manages the alert sources which are active for a service. Alert sources which are not active are not shown as \"not receiving\" alerts on the service. " +
+			"Use the `squadcast_alert_sources` data source to get the supported alert sources.",
+
+		CreateContext: resourceServiceAlertSourcesCreate,
+		ReadContext:   resourceServiceAlertSourcesRead,
+		UpdateContext: resourceServiceAlertSourcesUpdate,
+		DeleteContext: resourceServiceAlertSourcesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceServiceAlertSourcesImport,
+		},
+		CustomizeDiff: resourceServiceAlertSourcesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"service_id": {
+				Description:  "Service id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"alert_sources": {
+				Description: "Short names of the active alert sources, e.g. `prometheus`.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceServiceAlertSourcesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, serviceID, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.Set("service_id", serviceID)
+	d.SetId(serviceRulesID(teamID, serviceID))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodeServiceAlertSources maps the configured short names to alert source ids, see checkServiceAlertSources.
+func decodeServiceAlertSources(ctx context.Context, d *schema.ResourceData, client *api.Client) ([]string, diag.Diagnostics) {
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return checkServiceAlertSources(alertSources, tf.ListToSlice[string](d.Get("alert_sources").(*schema.Set).List()))
+}
+
+// checkServiceAlertSources maps short names to alert source ids. Short names have to be one of the
+// available alert sources, deprecated alert sources are accepted with a warning.
+func checkServiceAlertSources(alertSources api.AlertSourcesList, shortNames []string) ([]string, diag.Diagnostics) {
+	available := make(map[string]*api.AlertSource)
+	for _, as := range *alertSources.Available() {
+		available[as.ShortName] = as
+	}
+	deprecated := make(map[string]*api.AlertSource)
+	for _, as := range alertSources {
+		if as.IsValid && !as.IsPrivate && as.IsDeprecated {
+			deprecated[as.ShortName] = as
+		}
+	}
+
+	sort.Strings(shortNames)
+
+	var diags diag.Diagnostics
+	ids := make([]string, 0, len(shortNames))
+	for _, shortName := range shortNames {
+		if as, ok := available[shortName]; ok {
+			ids = append(ids, as.ID)
+			continue
+		}
+
+		if as, ok := deprecated[shortName]; ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Deprecated alert source",
+				Detail:   fmt.Sprintf("The alert source `%s` is deprecated, consider moving to one of its supported alternatives.", shortName),
+			})
+			ids = append(ids, as.ID)
+			continue
+		}
+
+		names := make([]string, 0, len(available))
+		for name := range available {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, append(diags, diag.Errorf("`%s` is not an available alert source, expected one of: %s", shortName, strings.Join(names, ", "))...)
+	}
+
+	return ids, diags
+}
+
+// resourceServiceAlertSourcesCustomizeDiff checks the configured short names at plan time, once they are all known.
+func resourceServiceAlertSourcesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*api.Client)
+
+	config := d.GetRawConfig().GetAttr("alert_sources")
+	if !config.IsWhollyKnown() || config.IsNull() {
+		return nil
+	}
+
+	shortNames := make([]string, 0, config.LengthInt())
+	for _, v := range config.AsValueSlice() {
+		shortNames = append(shortNames, v.AsString())
+	}
+
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, diags := checkServiceAlertSources(alertSources, shortNames); diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				return fmt.Errorf("alert_sources: %s", d.Summary)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceServiceAlertSourcesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	ids, diags := decodeServiceAlertSources(ctx, d, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Creating service_alert_sources", tf.M{
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	_, err := client.UpdateServiceAlertSources(ctx, d.Get("service_id").(string), &api.UpdateServiceAlertSourcesReq{AlertSourceIDs: ids})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	d.SetId(serviceRulesID(d.Get("team_id").(string), d.Get("service_id").(string)))
+
+	return append(diags, resourceServiceAlertSourcesRead(ctx, d, meta)...)
+}
+
+func resourceServiceAlertSourcesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	serviceID, ok := d.GetOk("service_id")
+	if !ok {
+		return diag.Errorf("invalid service id provided")
+	}
+
+	tflog.Info(ctx, "Reading service_alert_sources", tf.M{
+		"id":         d.Id(),
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	serviceAlertSources, err := client.GetServiceAlertSources(ctx, serviceID.(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	alertSources, err := client.ListAlertSources(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shortNames := make(map[string]string, len(alertSources))
+	for _, as := range alertSources {
+		shortNames[as.ID] = as.ShortName
+	}
+
+	active := make([]string, 0, len(serviceAlertSources.AlertSourceIDs))
+	for _, id := range serviceAlertSources.AlertSourceIDs {
+		if shortName, ok := shortNames[id]; ok {
+			active = append(active, shortName)
+		}
+	}
+
+	if err = d.Set("alert_sources", active); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceServiceAlertSourcesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	ids, diags := decodeServiceAlertSources(ctx, d, client)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := client.UpdateServiceAlertSources(ctx, d.Get("service_id").(string), &api.UpdateServiceAlertSourcesReq{AlertSourceIDs: ids})
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceServiceAlertSourcesRead(ctx, d, meta)...)
+}
+
+func resourceServiceAlertSourcesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateServiceAlertSources(ctx, d.Get("service_id").(string), &api.UpdateServiceAlertSourcesReq{AlertSourceIDs: []string{}})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func TestAccResourceServiceAlertSources(t *testing.T) {
+	serviceName := acctest.RandomWithPrefix("service")
+
+	resourceName := "squadcast_service_alert_sources.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceServiceAlertSourcesConfig(serviceName, `"prometheus"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^613611c1eb22db455cfa789f:[0-9a-f]{24}$")),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "alert_sources.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "alert_sources.*", "prometheus"),
+				),
+			},
+			{
+				Config: testAccResourceServiceAlertSourcesConfig(serviceName, `"grafana", "prometheus"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "alert_sources.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "alert_sources.*", "grafana"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "alert_sources.*", "prometheus"),
+				),
+			},
+			{
+				Config:      testAccResourceServiceAlertSourcesConfig(serviceName, `"not-an-alert-source"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("alert_sources: `not-an-alert-source` is not an available alert source"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					serviceID, err := tf.StateAttr(s, "squadcast_service", "id")
+					if err != nil {
+						return "", err
+					}
+
+					return "613611c1eb22db455cfa789f:" + serviceID, nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceServiceAlertSourcesConfig(serviceName string, alertSources string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_service_alert_sources" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = squadcast_service.test.id
+	alert_sources = [%s]
+}
+	`, serviceName, serviceName, alertSources)
+}
@@ -0,0 +1,617 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var serviceRulesLocks = struct {
+	sync.Mutex
+	locks map[string]*sync.Mutex
+}{locks: map[string]*sync.Mutex{}}
+
+// lockServiceRules locks the rules of the given kind of a service, e.g. its routing rules, and returns the function which unlocks them.
+// Rule resources read, modify and write the whole list of rules of a service, the lock prevents concurrent operations from overwriting each other.
+func lockServiceRules(kind, serviceID string) func() {
+	key := kind + ":" + serviceID
+
+	serviceRulesLocks.Lock()
+	lock, ok := serviceRulesLocks.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		serviceRulesLocks.locks[key] = lock
+	}
+	serviceRulesLocks.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+const (
+	onExistingFail      = "fail"
+	onExistingOverwrite = "overwrite"
+	onExistingAdopt     = "adopt"
+)
+
+// onExistingSchema is the schema of the `on_existing` attribute of the resources which manage the whole list of rules of a service.
+func onExistingSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Description: fmt.Sprintf("What to do on create when the service already has %s, e.g. configured in the UI: ", strings.ReplaceAll(kind, "_", " ")) +
+			"`fail` fails without changing them, `overwrite` replaces them by the configured rules, " +
+			"`adopt` imports them into the state without changing them, the next plan then shows the changes to make them match the configuration. " +
+			"Defaults to `overwrite`. Has no effect once the resource is created.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      onExistingOverwrite,
+		ValidateFunc: validation.StringInSlice([]string{onExistingFail, onExistingOverwrite, onExistingAdopt}, false),
+	}
+}
+
+// serviceRulesID returns the id of a resource which manages the whole list of rules of a service.
+func serviceRulesID(teamID, serviceID string) string {
+	return teamID + ":" + serviceID
+}
+
+// adoptServiceRules returns whether the existing rules of the given kind of a service, if any, must be adopted instead of overwritten
+// according to the `on_existing` attribute, or an error when they must not be changed.
+func adoptServiceRules(ctx context.Context, d *schema.ResourceData, kind string, existing int) (bool, error) {
+	if existing == 0 {
+		return false, nil
+	}
+
+	serviceID := d.Get("service_id").(string)
+	switch d.Get("on_existing").(string) {
+	case onExistingFail:
+		return false, fmt.Errorf("service %s already has %d %s, import them with `terraform import` or set on_existing to %q or %q", serviceID, existing, strings.ReplaceAll(kind, "_", " "), onExistingOverwrite, onExistingAdopt)
+	case onExistingAdopt:
+		tflog.Info(ctx, "Adopting existing "+kind, tf.M{
+			"service_id": serviceID,
+			"count":      existing,
+		})
+		return true, nil
+	default:
+		tflog.Warn(ctx, "Overwriting existing "+kind, tf.M{
+			"service_id": serviceID,
+			"count":      existing,
+		})
+		return false, nil
+	}
+}
+
+// serviceRule describes a resource which manages a single rule in the list of rules of a service, e.g. a routing rule,
+// without changing the rules it does not manage.
+type serviceRule[T tf.StateEncoder] struct {
+	// kind is the name of the list of rules, e.g. "routing_rules".
+	kind string
+	// rule is the schema of a rule, the same as the schema of the rules of the resource which manages the whole list.
+	rule map[string]*schema.Schema
+
+	// decode decodes a rule from its attributes, resolving the references to other resources, e.g. the target of a routing rule by name.
+	decode func(ctx context.Context, client *api.Client, teamID string, m tf.M) (T, error)
+	// refs are the attributes of a rule which reference other resources by name or email, e.g. `route_to_name`.
+	// The state holds the ids they were resolved to, so refs are ignored when decoding a rule from the state:
+	// a referenced resource which was renamed or deleted must not break refresh or destroy.
+	refs   []string
+	list   func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]T, error)
+	update func(ctx context.Context, client *api.Client, serviceID, teamID string, rules []T) error
+}
+
+func (s *serviceRule[T]) resource(description string) *schema.Resource {
+	ruleSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "id.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"team_id": {
+			Description:  "Team id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"service_id": {
+			Description:  "Service id.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: tf.ValidateObjectID,
+			ForceNew:     true,
+		},
+		"priority": {
+			Description: "Zero-based position of the rule in the rules of the service, rules are evaluated in order. " +
+				"The rule is moved to the end of the rules when there are less rules than its priority.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+	for k, v := range s.rule {
+		ruleSchema[k] = v
+	}
+
+	return &schema.Resource{
+		Description: description,
+
+		CreateContext: s.create,
+		ReadContext:   s.read,
+		UpdateContext: s.updateRule,
+		DeleteContext: s.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: s.importState,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+			return validateBasicExpressions(d.GetRawConfig(), "")
+		},
+
+		Schema: ruleSchema,
+	}
+}
+
+// decodeRule decodes the rule from the given attributes of the resource, e.g. d.Get.
+// When fromState is true, the attributes come from the state and refs are ignored.
+func (s *serviceRule[T]) decodeRule(ctx context.Context, client *api.Client, d *schema.ResourceData, get func(key string) any, fromState bool) (T, error) {
+	m := tf.M{}
+	for k := range s.rule {
+		m[k] = get(k)
+	}
+	return s.decode(ctx, client, d.Get("team_id").(string), s.withoutRefs(m, fromState))
+}
+
+// withoutRefs returns the attributes of the rule without refs when they come from the state.
+func (s *serviceRule[T]) withoutRefs(m tf.M, fromState bool) tf.M {
+	if !fromState || len(s.refs) == 0 {
+		return m
+	}
+	mrule := tf.M{}
+	for k, v := range m {
+		mrule[k] = v
+	}
+	for _, k := range s.refs {
+		delete(mrule, k)
+	}
+	return mrule
+}
+
+// setRule sets the attributes of the resource from the rule. Attributes which are not part of the rule in the API,
+// e.g. references by name, are kept as configured.
+func (s *serviceRule[T]) setRule(d *schema.ResourceData, rule T) error {
+	m, err := rule.Encode()
+	if err != nil {
+		return err
+	}
+	for k := range s.rule {
+		if _, ok := m[k]; !ok {
+			continue
+		}
+		if err := d.Set(k, m[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// find returns the index of the rule in the rules, or -1. Rules do not have an id, they are compared by value.
+func (s *serviceRule[T]) find(rules []T, rule T) (int, error) {
+	mrule, err := rule.Encode()
+	if err != nil {
+		return -1, err
+	}
+
+	for i, r := range rules {
+		m, err := r.Encode()
+		if err != nil {
+			return -1, err
+		}
+		if reflect.DeepEqual(m, mrule) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// write replaces the rule old, if not nil, by the rule new at the given priority, or removes it if new is nil.
+// It fails when the rules of the service already contain a rule identical to new.
+func (s *serviceRule[T]) write(ctx context.Context, client *api.Client, serviceID, teamID string, old, new *T, priority int) error {
+	unlock := lockServiceRules(s.kind, serviceID)
+	defer unlock()
+
+	rules, err := s.list(ctx, client, serviceID, teamID)
+	if err != nil {
+		return err
+	}
+
+	if old != nil {
+		i, err := s.find(rules, *old)
+		if err != nil {
+			return err
+		}
+		if i >= 0 {
+			rules = append(rules[:i:i], rules[i+1:]...)
+		}
+	}
+
+	if new != nil {
+		// Rules are found by value, two resources with identical rules would both claim the same rule.
+		i, err := s.find(rules, *new)
+		if err != nil {
+			return err
+		}
+		if i >= 0 {
+			return fmt.Errorf("an identical %s already exists at priority %d, identical rules of a service cannot be managed by separate resources", strings.TrimSuffix(s.kind, "s"), i)
+		}
+
+		if priority > len(rules) {
+			priority = len(rules)
+		}
+		rules = append(rules[:priority:priority], append([]T{*new}, rules[priority:]...)...)
+	}
+
+	return s.update(ctx, client, serviceID, teamID, rules)
+}
+
+func (s *serviceRule[T]) importState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of import resource id (%s), expected teamID:serviceID:priority", d.Id())
+	}
+	priority, err := strconv.Atoi(parts[2])
+	if err != nil || priority < 0 {
+		return nil, fmt.Errorf("unexpected format of import resource id (%s), expected priority to be a positive integer, got %s", d.Id(), parts[2])
+	}
+
+	rules, err := s.list(ctx, client, parts[1], parts[0])
+	if err != nil {
+		return nil, err
+	}
+	if priority >= len(rules) {
+		return nil, fmt.Errorf("[404] could not find the %s with the priority %d, the service has %d rules", strings.TrimSuffix(s.kind, "s"), priority, len(rules))
+	}
+
+	m, err := rules[priority].Encode()
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range s.rule {
+		// Attributes which are not part of the rule in the API have their default value.
+		value, ok := m[k]
+		if !ok {
+			value = v.Default
+		}
+		if err := d.Set(k, value); err != nil {
+			return nil, err
+		}
+	}
+
+	d.Set("team_id", parts[0])
+	d.Set("service_id", parts[1])
+	d.Set("priority", priority)
+	d.SetId(resource.UniqueId())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func (s *serviceRule[T]) create(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := s.decodeRule(ctx, client, d, d.Get, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating "+strings.TrimSuffix(s.kind, "s"), tf.M{
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+		"priority":   d.Get("priority").(int),
+	})
+	err = s.write(ctx, client, d.Get("service_id").(string), d.Get("team_id").(string), nil, &rule, d.Get("priority").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	// The rule is read using the resolved references.
+	if err := s.setRule(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return s.read(ctx, d, meta)
+}
+
+func (s *serviceRule[T]) read(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading "+strings.TrimSuffix(s.kind, "s"), tf.M{
+		"id":         d.Id(),
+		"team_id":    d.Get("team_id").(string),
+		"service_id": d.Get("service_id").(string),
+	})
+	rules, err := s.list(ctx, client, d.Get("service_id").(string), d.Get("team_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, err := s.decodeRule(ctx, client, d, d.Get, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	i, err := s.find(rules, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if i < 0 {
+		// The rule was changed or removed outside of Terraform.
+		d.SetId("")
+		return nil
+	}
+
+	if err := s.setRule(d, rules[i]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A rule whose priority is beyond the end of the rules is the last rule.
+	if priority := d.Get("priority").(int); i != priority && !(i == len(rules)-1 && priority > i) {
+		d.Set("priority", i)
+	}
+
+	return nil
+}
+
+func (s *serviceRule[T]) updateRule(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	old, err := s.decodeRule(ctx, client, d, func(key string) any {
+		o, _ := d.GetChange(key)
+		return o
+	}, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule, err := s.decodeRule(ctx, client, d, d.Get, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = s.write(ctx, client, d.Get("service_id").(string), d.Get("team_id").(string), &old, &rule, d.Get("priority").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := s.setRule(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return s.read(ctx, d, meta)
+}
+
+func (s *serviceRule[T]) delete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	rule, err := s.decodeRule(ctx, client, d, d.Get, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = s.write(ctx, client, d.Get("service_id").(string), d.Get("team_id").(string), &rule, nil, 0)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// serviceRulesHash returns the content hash of the rules of a service, as returned by the API.
+// Resources which manage the whole list of rules store the hash of the rules they last read, to detect changes made outside of Terraform before updating them.
+func serviceRulesHash[T any](rules []T) (string, error) {
+	b, err := json.Marshal(rules)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// rulesHashSchema is the schema of the `rules_hash` attribute of the resources which manage the whole list of rules of a service.
+func rulesHashSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Content hash of the rules of the service when they were last read. " +
+			"Before updating the rules, the provider checks they did not change since, e.g. in the UI: " +
+			"changes to other rules than the ones being updated are kept, otherwise the apply fails and shows the changes.",
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// maxServiceRulesAttempts is the number of times the rules of a service are read, merged and written when the API reports a conflict.
+const maxServiceRulesAttempts = 3
+
+// decodeRules decodes the `rules` attribute of a resource which manages the whole list of rules of a service.
+// When fromState is true, the rules come from the state and refs are ignored.
+func (s *serviceRule[T]) decodeRules(ctx context.Context, client *api.Client, teamID string, mrules []any, fromState bool) ([]T, error) {
+	rules := make([]T, len(mrules))
+	for i, mrule := range mrules {
+		rule, err := s.decode(ctx, client, teamID, s.withoutRefs(mrule.(tf.M), fromState))
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+func (s *serviceRule[T]) encodeRules(rules []T) ([]tf.M, error) {
+	m := make([]tf.M, len(rules))
+	for i, rule := range rules {
+		mrule, err := rule.Encode()
+		if err != nil {
+			return nil, err
+		}
+		m[i] = mrule
+	}
+	return m, nil
+}
+
+// updateRules replaces the rules of the service by the `rules` attribute of a resource which manages the whole list of rules.
+//
+// The rules of the service are read first and compared with the rules last read, using `rules_hash`.
+// When they changed outside of Terraform, the changes are kept if they are to other rules than the ones being updated,
+// otherwise the update fails with a diagnostic showing them. Rules are compared by index, so rules added or removed outside of Terraform always fail the update.
+func (s *serviceRule[T]) updateRules(ctx context.Context, client *api.Client, d *schema.ResourceData) diag.Diagnostics {
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	unlock := lockServiceRules(s.kind, serviceID)
+	defer unlock()
+
+	mbase, _ := d.GetChange("rules")
+	base, err := s.decodeRules(ctx, client, teamID, mbase.([]any), true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	local, err := s.decodeRules(ctx, client, teamID, d.Get("rules").([]any), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		remote, err := s.list(ctx, client, serviceID, teamID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rules, mergeDiags := s.merge(d.Get("rules_hash").(string), serviceID, base, remote, local)
+		if mergeDiags.HasError() {
+			return mergeDiags
+		}
+		if rules == nil {
+			// The rules are not changed, e.g. only on_existing is.
+			return nil
+		}
+
+		tflog.Info(ctx, "Updating "+s.kind, tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+			"attempt":    attempt,
+		})
+		err = s.update(ctx, client, serviceID, teamID, rules)
+		if err == nil {
+			return mergeDiags
+		}
+		if !api.IsConflictError(err) || attempt == maxServiceRulesAttempts {
+			detail := err.Error()
+			if data, ok := api.ConflictData(err); ok {
+				if b, err := json.MarshalIndent(data, "", "  "); err == nil {
+					detail += "\n\nconflict data:\n" + string(b)
+				}
+			}
+			return append(mergeDiags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Could not update the %s of service %s", strings.ReplaceAll(s.kind, "_", " "), serviceID),
+				Detail:   detail,
+			})
+		}
+		tflog.Warn(ctx, "Conflict while updating "+s.kind+", retrying", tf.M{
+			"service_id": serviceID,
+			"attempt":    attempt,
+		})
+	}
+}
+
+// merge returns the rules to write, given the rules base last read, whose hash is baseHash, the rules remote currently configured and the rules local to write.
+// It returns nil rules when local are the same as base.
+func (s *serviceRule[T]) merge(baseHash, serviceID string, base, remote, local []T) ([]T, diag.Diagnostics) {
+	mbase, err := s.encodeRules(base)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	mremote, err := s.encodeRules(remote)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	mlocal, err := s.encodeRules(local)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if reflect.DeepEqual(mlocal, mbase) {
+		return nil, nil
+	}
+
+	remoteHash, err := serviceRulesHash(remote)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if remoteHash == baseHash || reflect.DeepEqual(mremote, mbase) {
+		return local, nil
+	}
+
+	name := strings.ReplaceAll(s.kind, "_", " ")
+	changed := make([]int, 0)
+	overlaps := len(mremote) != len(mbase) || len(mlocal) != len(mbase)
+	for i := 0; i < len(mremote) && i < len(mbase); i++ {
+		if !reflect.DeepEqual(mremote[i], mbase[i]) {
+			changed = append(changed, i)
+			if i < len(mlocal) && !reflect.DeepEqual(mlocal[i], mbase[i]) {
+				overlaps = true
+			}
+		}
+	}
+
+	if overlaps {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("The %s of service %s changed outside of Terraform", name, serviceID),
+			Detail: "The rules changed since they were last read and the changes overlap with the rules being updated, " +
+				"refresh the state and review the changes before applying again, e.g. by adding them to the configuration.\n\n" + diffServiceRules(mbase, mremote),
+		}}
+	}
+
+	merged := make([]T, len(local))
+	copy(merged, local)
+	for _, i := range changed {
+		merged[i] = remote[i]
+	}
+	return merged, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Kept changes to the %s of service %s made outside of Terraform", name, serviceID),
+		Detail: fmt.Sprintf("The rules %v changed since they were last read and were kept, the next plan shows the changes to make them match the configuration.\n\n", changed) +
+			diffServiceRules(mbase, mremote),
+	}}
+}
+
+// diffServiceRules describes the changes from the rules base to the rules remote, one line per rule.
+func diffServiceRules(base, remote []tf.M) string {
+	line := func(prefix string, i int, m tf.M) string {
+		b, _ := json.Marshal(m)
+		return fmt.Sprintf("%s rule %d: %s\n", prefix, i, b)
+	}
+
+	var diff strings.Builder
+	for i := 0; i < len(base) || i < len(remote); i++ {
+		switch {
+		case i >= len(remote):
+			diff.WriteString(line("-", i, base[i]))
+		case i >= len(base):
+			diff.WriteString(line("+", i, remote[i]))
+		case !reflect.DeepEqual(base[i], remote[i]):
+			diff.WriteString(line("-", i, base[i]))
+			diff.WriteString(line("+", i, remote[i]))
+		}
+	}
+	return diff.String()
+}
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func testSuppressionRule(expression string) *api.SuppressionRule {
+	return &api.SuppressionRule{Expression: expression}
+}
+
+func testSuppressionRules(expressions ...string) []*api.SuppressionRule {
+	r := make([]*api.SuppressionRule, len(expressions))
+	for i, expression := range expressions {
+		r[i] = testSuppressionRule(expression)
+	}
+	return r
+}
+
+// fakeSuppressionServiceRule returns the suppression rules of a service whose rules are stored in rules instead of the API.
+func fakeSuppressionServiceRule(rules *[]*api.SuppressionRule) *serviceRule[*api.SuppressionRule] {
+	s := suppressionServiceRule()
+	s.list = func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]*api.SuppressionRule, error) {
+		return append([]*api.SuppressionRule(nil), *rules...), nil
+	}
+	s.update = func(ctx context.Context, client *api.Client, serviceID, teamID string, r []*api.SuppressionRule) error {
+		*rules = r
+		return nil
+	}
+	return s
+}
+
+func TestServiceRuleFind(t *testing.T) {
+	s := suppressionServiceRule()
+	rules := testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.a == 1")
+
+	cases := []struct {
+		name string
+		rule *api.SuppressionRule
+		want int
+	}{
+		{name: "first rule", rule: testSuppressionRule("payload.a == 1"), want: 0},
+		{name: "second rule", rule: testSuppressionRule("payload.b == 1"), want: 1},
+		{name: "missing rule", rule: testSuppressionRule("payload.c == 1"), want: -1},
+		{name: "same expression, other attributes", rule: &api.SuppressionRule{Expression: "payload.b == 1", IsBasic: true}, want: -1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := s.find(rules, c.rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Fatalf("expected %d, got %d", c.want, got)
+			}
+		})
+	}
+}
+
+func TestServiceRuleWrite(t *testing.T) {
+	cases := []struct {
+		name     string
+		rules    []*api.SuppressionRule
+		old      *api.SuppressionRule
+		new      *api.SuppressionRule
+		priority int
+		want     []*api.SuppressionRule
+		error    string
+	}{
+		{
+			name:     "insert",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 1"),
+			new:      testSuppressionRule("payload.c == 1"),
+			priority: 1,
+			want:     testSuppressionRules("payload.a == 1", "payload.c == 1", "payload.b == 1"),
+		},
+		{
+			name:     "insert into no rules",
+			new:      testSuppressionRule("payload.c == 1"),
+			priority: 0,
+			want:     testSuppressionRules("payload.c == 1"),
+		},
+		{
+			name:     "priority beyond the end",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 1"),
+			new:      testSuppressionRule("payload.c == 1"),
+			priority: 10,
+			want:     testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.c == 1"),
+		},
+		{
+			name:     "replace",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.c == 1"),
+			old:      testSuppressionRule("payload.b == 1"),
+			new:      testSuppressionRule("payload.d == 1"),
+			priority: 1,
+			want:     testSuppressionRules("payload.a == 1", "payload.d == 1", "payload.c == 1"),
+		},
+		{
+			name:     "move",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.c == 1"),
+			old:      testSuppressionRule("payload.a == 1"),
+			new:      testSuppressionRule("payload.a == 1"),
+			priority: 2,
+			want:     testSuppressionRules("payload.b == 1", "payload.c == 1", "payload.a == 1"),
+		},
+		{
+			name:     "replace a rule changed outside of Terraform",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 2"),
+			old:      testSuppressionRule("payload.b == 1"),
+			new:      testSuppressionRule("payload.d == 1"),
+			priority: 1,
+			want:     testSuppressionRules("payload.a == 1", "payload.d == 1", "payload.b == 2"),
+		},
+		{
+			name:  "remove",
+			rules: testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.c == 1"),
+			old:   testSuppressionRule("payload.b == 1"),
+			want:  testSuppressionRules("payload.a == 1", "payload.c == 1"),
+		},
+		{
+			name:  "remove a missing rule",
+			rules: testSuppressionRules("payload.a == 1", "payload.c == 1"),
+			old:   testSuppressionRule("payload.b == 1"),
+			want:  testSuppressionRules("payload.a == 1", "payload.c == 1"),
+		},
+		{
+			name:     "identical rule",
+			rules:    testSuppressionRules("payload.a == 1", "payload.b == 1"),
+			new:      testSuppressionRule("payload.b == 1"),
+			priority: 0,
+			error:    "an identical suppression_rule already exists at priority 1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rules := c.rules
+			s := fakeSuppressionServiceRule(&rules)
+
+			var old, new **api.SuppressionRule
+			if c.old != nil {
+				old = &c.old
+			}
+			if c.new != nil {
+				new = &c.new
+			}
+			err := s.write(context.Background(), nil, "61361611c2fc70c3101ca7dd", "613611c1eb22db455cfa789f", old, new, c.priority)
+
+			if c.error != "" {
+				if err == nil || !strings.Contains(err.Error(), c.error) {
+					t.Fatalf("expected an error containing %q, got %v", c.error, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			gotm, _ := s.encodeRules(rules)
+			wantm, _ := s.encodeRules(c.want)
+			if diffServiceRules(wantm, gotm) != "" {
+				t.Fatalf("expected %v, got %v", wantm, gotm)
+			}
+		})
+	}
+}
+
+func TestServiceRuleMerge(t *testing.T) {
+	s := suppressionServiceRule()
+	rules := testSuppressionRules
+
+	base := testSuppressionRules("payload.a == 1", "payload.b == 1", "payload.c == 1")
+	baseHash, err := serviceRulesHash(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		remote  []*api.SuppressionRule
+		local   []*api.SuppressionRule
+		want    []*api.SuppressionRule
+		warning bool
+		error   string
+	}{
+		{
+			name:   "unchanged rules",
+			remote: rules("payload.a == 2", "payload.b == 1", "payload.c == 1"),
+			local:  base,
+			want:   nil,
+		},
+		{
+			name:   "no remote change",
+			remote: base,
+			local:  rules("payload.a == 1", "payload.b == 2"),
+			want:   rules("payload.a == 1", "payload.b == 2"),
+		},
+		{
+			name:    "remote change to another rule",
+			remote:  rules("payload.a == 1", "payload.b == 1", "payload.c == 3"),
+			local:   rules("payload.a == 2", "payload.b == 1", "payload.c == 1"),
+			want:    rules("payload.a == 2", "payload.b == 1", "payload.c == 3"),
+			warning: true,
+		},
+		{
+			name:   "remote change to the same rule",
+			remote: rules("payload.a == 3", "payload.b == 1", "payload.c == 1"),
+			local:  rules("payload.a == 2", "payload.b == 1", "payload.c == 1"),
+			error:  "- rule 0: ",
+		},
+		{
+			name:   "remote rule added",
+			remote: rules("payload.a == 1", "payload.b == 1", "payload.c == 1", "payload.d == 1"),
+			local:  rules("payload.a == 2", "payload.b == 1", "payload.c == 1"),
+			error:  "+ rule 3: ",
+		},
+		{
+			name:   "local rule removed",
+			remote: rules("payload.a == 1", "payload.b == 1", "payload.c == 3"),
+			local:  rules("payload.a == 1", "payload.b == 1"),
+			error:  "+ rule 2: ",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, diags := s.merge(baseHash, "61361611c2fc70c3101ca7dd", base, c.remote, c.local)
+
+			if c.error != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				if !strings.Contains(diags[0].Detail, c.error) {
+					t.Fatalf("expected the diff to contain %q, got %q", c.error, diags[0].Detail)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if warning := len(diags) > 0; warning != c.warning {
+				t.Fatalf("expected warning to be %v, got %v", c.warning, diags)
+			}
+
+			gotm, _ := s.encodeRules(got)
+			wantm, _ := s.encodeRules(c.want)
+			if (got == nil) != (c.want == nil) || diffServiceRules(wantm, gotm) != "" {
+				t.Fatalf("expected %v, got %v", wantm, gotm)
+			}
+		})
+	}
+}
+
+func TestServiceRuleDecodeRulesFromState(t *testing.T) {
+	s := routingServiceRule()
+	// The name is not resolved with a client, which would fail when the schedule was renamed or deleted.
+	mrules := []any{tf.M{
+		"is_basic":       false,
+		"expression":     "payload.a == 1",
+		"route_to_id":    "6136120b7a8a7bb7b3d3b3c3",
+		"route_to_type":  "schedule",
+		"route_to_name":  "deleted schedule",
+		"route_to_email": "",
+	}}
+
+	rules, err := s.decodeRules(context.Background(), nil, "613611c1eb22db455cfa789f", mrules, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := api.RouteTo{EntityID: "6136120b7a8a7bb7b3d3b3c3", EntityType: "schedule"}
+	if rules[0].RouteTo != want {
+		t.Fatalf("expected %v, got %v", want, rules[0].RouteTo)
+	}
+	if mrules[0].(tf.M)["route_to_name"] != "deleted schedule" {
+		t.Fatalf("expected the attributes not to be changed, got %v", mrules[0])
+	}
+}
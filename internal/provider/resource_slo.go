@@ -20,6 +20,9 @@ func resourceSlo() *schema.Resource {
 		ReadContext:   resourceSloRead,
 		UpdateContext: resourceSloUpdate,
 		DeleteContext: resourceSloDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSloImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -127,7 +130,7 @@ func resourceSlo() *schema.Resource {
 				Optional: true,
 			},
 			"notify": {
-				Description: "Notification rules for SLO violation" +
+				Description: "Notification rules for SLO violation. " +
 					"User can either choose to create an incident or get alerted via email",
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -145,18 +148,29 @@ func resourceSlo() *schema.Resource {
 							Computed:    true,
 						},
 						"user_ids": {
-							Description: "List of user ID's who should be alerted via email.",
-							Type:        schema.TypeList,
+							Description: "Set of user ID's who should be alerted via email.",
+							Type:        schema.TypeSet,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: tf.ValidateObjectID,
 							},
 							Optional: true,
 						},
 						"squad_ids": {
-							Description: "List of Squad ID's who should be alerted via email.",
-							Type:        schema.TypeList,
+							Description: "Set of Squad ID's who should be alerted via email.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: tf.ValidateObjectID,
+							},
+							Optional: true,
+						},
+						"service_ids": {
+							Description: "Set of service ID's in which an incident should be created.",
+							Type:        schema.TypeSet,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: tf.ValidateObjectID,
 							},
 							Optional: true,
 						},
@@ -165,12 +179,13 @@ func resourceSlo() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: tf.ValidateObjectID,
+							Deprecated:   "Use `service_ids` instead.",
 						},
 					},
 				},
 			},
 			"team_id": {
-				Description:  "The team which SLO resource belongs to",
+				Description:  "The team which SLO resource belongs to, SLOs are always owned by a team.",
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: tf.ValidateObjectID,
@@ -190,31 +205,37 @@ var alertsMap = map[string]string{"is_breached_err_budget": "breached_error_budg
 	"remaining_error_budget":              "remaining_err_budget_threshold",
 }
 
+func resourceSloImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 	rules := make([]*api.SloMonitoringCheck, 0)
-	notify := make([]*api.SloNotify, 0)
-	sloActions := make([]*api.SloAction, 0)
 
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = Decode(d.Get("notify"), &notify)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	ownerID := d.Get("team_id").(string)
 
-	sloActions = formatRulesAndNotify(rules, notify, 0)
+	formatSloRules(rules, 0)
+	sloActions := decodeSloActions(d, 0, nil)
 
 	tflog.Info(ctx, "Creating Slos", map[string]interface{}{
 		"name": d.Get("name").(string),
 	})
 
-	slo, err := client.CreateSlo(ctx, client.OrganizationID, ownerID, &api.Slo{
+	slo, err := client.CreateSlo(ctx, ownerID, &api.Slo{
 		Name:                d.Get("name").(string),
 		Description:         d.Get("description").(string),
 		TargetSlo:           d.Get("target_slo").(float64),
@@ -226,6 +247,7 @@ func resourceSloCreate(ctx context.Context, d *schema.ResourceData, meta any) di
 		EndTime:             d.Get("end_time").(string),
 		SloMonitoringChecks: rules,
 		SloActions:          sloActions,
+		OwnerType:           api.SloOwnerTypeTeam,
 		OwnerID:             ownerID,
 	})
 	if err != nil {
@@ -255,8 +277,12 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		"team_id": d.Get("team_id").(string),
 	})
 
-	slo, err := client.GetSlo(ctx, client.OrganizationID, teamID.(string), sloID.(string))
+	slo, err := client.GetSlo(ctx, teamID.(string), sloID.(string))
 	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 
@@ -264,7 +290,23 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 		alert.Name = alertsMap[alert.Name]
 	}
 
-	if err = tf.EncodeAndSet(slo, d); err != nil {
+	m, err := slo.Encode()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keep the service in the deprecated `service_id` attribute as long as the configuration uses it.
+	if serviceID := d.Get("notify.0.service_id").(string); serviceID != "" {
+		for _, n := range m["notify"].([]any) {
+			notify := n.(tf.M)
+			if serviceIDs := notify["service_ids"].([]string); len(serviceIDs) == 1 && serviceIDs[0] == serviceID {
+				notify["service_id"] = serviceID
+				notify["service_ids"] = []string{}
+			}
+		}
+	}
+
+	if err = tf.SetState(d, m); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -274,35 +316,29 @@ func resourceSloRead(ctx context.Context, d *schema.ResourceData, meta any) diag
 func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 	var rules []*api.SloMonitoringCheck
-	sloActions := make([]*api.SloAction, 0)
-	notify := make([]*api.SloNotify, 0)
 
 	err := Decode(d.Get("rules"), &rules)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = Decode(d.Get("notify"), &notify)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	sloID, _ := strconv.ParseInt(d.Id(), 10, 32)
 	ownerID := d.Get("team_id").(string)
+	id := d.Id()
 
-	sloActions = formatRulesAndNotify(rules, notify, sloID)
-
-	tflog.Info(ctx, "Updating Slos", map[string]interface{}{
-		"name": d.Get("name").(string),
-	})
+	current, err := client.GetSlo(ctx, ownerID, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	id := d.Id()
+	formatSloRules(rules, sloID)
+	sloActions := decodeSloActions(d, sloID, current.SloActions)
 
 	tflog.Info(ctx, "Updating Slos", map[string]interface{}{
 		"name": d.Get("name").(string),
 	})
 
-	_, err = client.UpdateSlo(ctx, client.OrganizationID, ownerID, id, &api.Slo{
+	_, err = client.UpdateSlo(ctx, ownerID, id, &api.Slo{
 		Name:                d.Get("name").(string),
 		Description:         d.Get("description").(string),
 		TargetSlo:           d.Get("target_slo").(float64),
@@ -314,6 +350,7 @@ func resourceSloUpdate(ctx context.Context, d *schema.ResourceData, meta any) di
 		EndTime:             d.Get("end_time").(string),
 		SloMonitoringChecks: rules,
 		SloActions:          sloActions,
+		OwnerType:           api.SloOwnerTypeTeam,
 		OwnerID:             ownerID,
 	})
 	if err != nil {
@@ -335,7 +372,7 @@ func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 		return diag.Errorf("invalid team id")
 	}
 
-	_, err := client.DeleteSlo(ctx, client.OrganizationID, teamID.(string), d.Id())
+	_, err := client.DeleteSlo(ctx, teamID.(string), d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -343,40 +380,59 @@ func resourceSloDelete(ctx context.Context, d *schema.ResourceData, meta any) di
 	return nil
 }
 
-// formatRulesAndNotify transform the payload into the format expected by the API and terraform state
-func formatRulesAndNotify(rules []*api.SloMonitoringCheck, notify []*api.SloNotify, sloID int64) []*api.SloAction {
-	sloActions := make([]*api.SloAction, 0)
+// formatSloRules transform the monitoring checks into the format expected by the API
+func formatSloRules(rules []*api.SloMonitoringCheck, sloID int64) {
 	for _, alert := range rules {
 		alert.Name = alertsMap[alert.Name]
 		alert.IsChecked = true
 		alert.SloID = sloID
 	}
+}
 
-	for _, userID := range notify[0].UserIDs {
-		user := &api.SloAction{
-			Type:   "USER",
-			UserID: userID,
-			SloID:  sloID,
-		}
-		sloActions = append(sloActions, user)
+// decodeSloActions returns one action for every user, squad and service of the `notify` block.
+// Actions which already exist on the SLO keep their ID, so that they are updated in place instead of being recreated.
+func decodeSloActions(d *schema.ResourceData, sloID int64, existing []*api.SloAction) []*api.SloAction {
+	sloActions := make([]*api.SloAction, 0)
+
+	notify := d.Get("notify").([]any)
+	if len(notify) == 0 || notify[0] == nil {
+		return sloActions
 	}
+	n := notify[0].(tf.M)
 
-	for _, squadID := range notify[0].SquadIDs {
-		user := &api.SloAction{
-			Type:   "SQUAD",
-			UserID: squadID,
-			SloID:  sloID,
-		}
-		sloActions = append(sloActions, user)
+	serviceIDs := n["service_ids"].(*schema.Set).List()
+	if serviceID := n["service_id"].(string); serviceID != "" {
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+
+	targets := []struct {
+		actionType string
+		ids        []any
+	}{
+		{api.SloActionTypeUser, n["user_ids"].(*schema.Set).List()},
+		{api.SloActionTypeSquad, n["squad_ids"].(*schema.Set).List()},
+		{api.SloActionTypeService, serviceIDs},
+	}
+
+	existingIDs := map[string]uint{}
+	for _, action := range existing {
+		existingIDs[action.Type+":"+action.TargetID()] = action.ID
 	}
 
-	if notify[0].ServiceID != "" {
-		service := &api.SloAction{
-			Type:   "SERVICE",
-			UserID: notify[0].ServiceID,
-			SloID:  sloID,
+	seen := map[string]bool{}
+	for _, target := range targets {
+		for _, id := range target.ids {
+			key := target.actionType + ":" + id.(string)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			action := api.NewSloAction(target.actionType, id.(string))
+			action.ID = existingIDs[key]
+			action.SloID = sloID
+			sloActions = append(sloActions, action)
 		}
-		sloActions = append(sloActions, service)
 	}
 
 	return sloActions
@@ -35,7 +35,7 @@ func TestAccResourceSlo(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "slis.0", "latency"),
 					resource.TestCheckResourceAttr(resourceName, "notify.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "notify.0.user_ids.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "notify.0.user_ids.0", "6113b0ffe4d98ae048c37010"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notify.0.user_ids.*", "6113b0ffe4d98ae048c37010"),
 					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "breached_error_budget"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.name", "unhealthy_slo"),
@@ -59,8 +59,10 @@ func TestAccResourceSlo(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "slis.1", "high-err-rate"),
 					resource.TestCheckResourceAttr(resourceName, "notify.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "notify.0.user_ids.#", "2"),
-					resource.TestCheckResourceAttr(resourceName, "notify.0.user_ids.0", "6113b0ffe4d98ae048c37010"),
-					resource.TestCheckResourceAttr(resourceName, "notify.0.user_ids.1", "61305a78127c63c6d2c8f746"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notify.0.user_ids.*", "6113b0ffe4d98ae048c37010"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notify.0.user_ids.*", "61305a78127c63c6d2c8f746"),
+					resource.TestCheckResourceAttr(resourceName, "notify.0.service_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "notify.0.service_ids.*", "6257a8eb3c8ff45615ce5f2e"),
 					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "breached_error_budget"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.name", "unhealthy_slo"),
@@ -70,6 +72,12 @@ func TestAccResourceSlo(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "team_id", "61443b953ffd52818bf1616a"),
 				),
 			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "61443b953ffd52818bf1616a:",
+			},
 		},
 	})
 }
@@ -81,9 +89,13 @@ func testAccCheckSloDestroy(s *terraform.State) error {
 			continue
 		}
 
-		slo, _ := client.GetSlo(context.Background(), client.OrganizationID, rs.Primary.Attributes["id"], rs.Primary.Attributes["team_id"])
-		if slo != nil {
-			return fmt.Errorf("expected slo to be destroyed, %s found", slo.Name)
+		_, err := client.GetSlo(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected slo to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
 		}
 	}
 	return nil
@@ -148,6 +160,7 @@ resource "squadcast_slo" "test" {
 	
 	notify {
 		user_ids = ["6113b0ffe4d98ae048c37010", "61305a78127c63c6d2c8f746"]
+		service_ids = ["6257a8eb3c8ff45615ce5f2e"]
 	}
 
 	team_id = "61443b953ffd52818bf1616a"
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+var statusPageDomainRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+func resourceStatusPage() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Status Pages](https://support.squadcast.com/docs/status-pages) let you communicate the health of your services to your customers. Use `squadcast_status_page_component_group` and `squadcast_status_page_component` to add components to the page.",
+
+		CreateContext: resourceStatusPageCreate,
+		ReadContext:   resourceStatusPageRead,
+		UpdateContext: resourceStatusPageUpdate,
+		DeleteContext: resourceStatusPageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Status page id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Status page.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the Status page.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"is_public": {
+				Description: "Denotes if the status page is publicly accessible.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"domain_name": {
+				Description:  "Subdomain on which the status page is hosted by Squadcast.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(statusPageDomainRegexp, "must only contain lowercase letters, digits and hyphens"),
+			},
+			"custom_domain_name": {
+				Description: "Custom domain name for the status page, e.g. `status.example.com`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"url": {
+				Description: "Public URL of the status page.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"timezone": {
+				Description:  "IANA time zone in which the status page displays times, e.g. `America/New_York`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: tf.ValidateTimeZone,
+			},
+			"logo_url": {
+				Description:  "URL of the logo displayed on the status page.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"contact_email": {
+				Description:  "Support email displayed on the status page.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"hide_from_search_engines": {
+				Description: "Prevents search engines from indexing the status page.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"theme_color": {
+				Description: "Branding colors of the status page.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Description:  "Primary color, hex value.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateHexColor,
+						},
+						"secondary": {
+							Description:  "Secondary color, hex value.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: tf.ValidateHexColor,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStatusPageImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeStatusPage(d *schema.ResourceData) *api.CreateUpdateStatusPageReq {
+	return &api.CreateUpdateStatusPageReq{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		TeamID:                d.Get("team_id").(string),
+		IsPublic:              d.Get("is_public").(bool),
+		DomainName:            d.Get("domain_name").(string),
+		CustomDomainName:      d.Get("custom_domain_name").(string),
+		Timezone:              d.Get("timezone").(string),
+		LogoURL:               d.Get("logo_url").(string),
+		ContactEmail:          d.Get("contact_email").(string),
+		HideFromSearchEngines: d.Get("hide_from_search_engines").(bool),
+		ThemeColor: api.StatusPageThemeColor{
+			Primary:   d.Get("theme_color.0.primary").(string),
+			Secondary: d.Get("theme_color.0.secondary").(string),
+		},
+	}
+}
+
+func resourceStatusPageCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating status_page", tf.M{
+		"name": d.Get("name").(string),
+	})
+	statusPage, err := client.CreateStatusPage(ctx, decodeStatusPage(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(statusPage.ID), 10))
+
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading status_page", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	statusPage, err := client.GetStatusPageById(ctx, teamID.(string), id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(statusPage, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateStatusPage(ctx, d.Id(), decodeStatusPage(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStatusPageRead(ctx, d, meta)
+}
+
+func resourceStatusPageDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteStatusPage(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
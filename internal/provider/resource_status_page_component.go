@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceStatusPageComponent() *schema.Resource {
+	return &schema.Resource{
+		Description: "Components are the individual parts of your product whose health is displayed on a `squadcast_status_page`. A component can be mapped to a `squadcast_service`, so that incidents of the service are reflected on the status page.",
+
+		CreateContext: resourceStatusPageComponentCreate,
+		ReadContext:   resourceStatusPageComponentRead,
+		UpdateContext: resourceStatusPageComponentUpdate,
+		DeleteContext: resourceStatusPageComponentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageComponentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Component id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description:  "Status page id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ForceNew:     true,
+			},
+			"group_id": {
+				Description:  "Id of the `squadcast_status_page_component_group` this component belongs to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Description:  "Name of the component.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the component.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"service_id": {
+				Description:  "Id of the `squadcast_service` whose incidents are reflected on this component.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidateObjectID,
+			},
+		},
+	}
+}
+
+func resourceStatusPageComponentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	statusPageID, id, err := parseStatusPageChildImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("status_page_id", statusPageID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeStatusPageComponent(d *schema.ResourceData) (*api.CreateUpdateStatusPageComponentReq, error) {
+	req := &api.CreateUpdateStatusPageComponentReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if serviceID := d.Get("service_id").(string); serviceID != "" {
+		req.ServiceID = &serviceID
+	}
+
+	if groupID := d.Get("group_id").(string); groupID != "" {
+		id, err := strconv.ParseUint(groupID, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		gid := uint(id)
+		req.GroupID = &gid
+	}
+
+	return req, nil
+}
+
+func resourceStatusPageComponentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeStatusPageComponent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating status_page_component", tf.M{
+		"name": d.Get("name").(string),
+	})
+	component, err := client.CreateStatusPageComponent(ctx, d.Get("status_page_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(component.ID), 10))
+
+	return resourceStatusPageComponentRead(ctx, d, meta)
+}
+
+func resourceStatusPageComponentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	statusPageID, ok := d.GetOk("status_page_id")
+	if !ok {
+		return diag.Errorf("invalid status page id provided")
+	}
+
+	tflog.Info(ctx, "Reading status_page_component", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	component, err := client.GetStatusPageComponentById(ctx, statusPageID.(string), id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(component, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageComponentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeStatusPageComponent(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateStatusPageComponent(ctx, d.Get("status_page_id").(string), d.Id(), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStatusPageComponentRead(ctx, d, meta)
+}
+
+func resourceStatusPageComponentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteStatusPageComponent(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
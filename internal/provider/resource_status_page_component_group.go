@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceStatusPageComponentGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Component groups let you organise the components of a `squadcast_status_page`.",
+
+		CreateContext: resourceStatusPageComponentGroupCreate,
+		ReadContext:   resourceStatusPageComponentGroupRead,
+		UpdateContext: resourceStatusPageComponentGroupUpdate,
+		DeleteContext: resourceStatusPageComponentGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStatusPageComponentGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Component group id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status_page_id": {
+				Description:  "Status page id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ForceNew:     true,
+			},
+			"name": {
+				Description:  "Name of the component group.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the component group.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+		},
+	}
+}
+
+func parseStatusPageChildImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of import resource id (%s), expected statusPageID:ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func resourceStatusPageComponentGroupImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	statusPageID, id, err := parseStatusPageChildImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("status_page_id", statusPageID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceStatusPageComponentGroupCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Creating status_page_component_group", tf.M{
+		"name": d.Get("name").(string),
+	})
+	group, err := client.CreateStatusPageComponentGroup(ctx, d.Get("status_page_id").(string), &api.CreateUpdateStatusPageComponentGroupReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatUint(uint64(group.ID), 10))
+
+	return resourceStatusPageComponentGroupRead(ctx, d, meta)
+}
+
+func resourceStatusPageComponentGroupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	statusPageID, ok := d.GetOk("status_page_id")
+	if !ok {
+		return diag.Errorf("invalid status page id provided")
+	}
+
+	tflog.Info(ctx, "Reading status_page_component_group", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	group, err := client.GetStatusPageComponentGroupById(ctx, statusPageID.(string), id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(group, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceStatusPageComponentGroupUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateStatusPageComponentGroup(ctx, d.Get("status_page_id").(string), d.Id(), &api.CreateUpdateStatusPageComponentGroupReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceStatusPageComponentGroupRead(ctx, d, meta)
+}
+
+func resourceStatusPageComponentGroupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteStatusPageComponentGroup(ctx, d.Get("status_page_id").(string), d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceStatusPageComponentGroup(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resourceName := "squadcast_status_page_component_group.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckStatusPageComponentGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageComponentGroupConfig(statusPageName, "Backend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "status_page_id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Backend"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageComponentGroupConfig(statusPageName, "Frontend"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "status_page_id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Frontend"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStatusPageChildImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccStatusPageChildImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("cannot find %s in the state", resourceName)
+		}
+
+		return rs.Primary.Attributes["status_page_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func testAccCheckStatusPageComponentGroupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_status_page_component_group" {
+			continue
+		}
+
+		_, err := client.GetStatusPageComponentGroupById(context.Background(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected status page component group to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStatusPageComponentGroupConfig(statusPageName, groupName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+
+resource "squadcast_status_page_component_group" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "%s"
+}
+	`, statusPageName, statusPageName, groupName)
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceStatusPageComponent(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resourceName := "squadcast_status_page_component.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckStatusPageComponentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageComponentConfig(statusPageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "status_page_id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "API"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "service_id", ""),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageComponentConfig_update(statusPageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "status_page_id", "squadcast_status_page.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "squadcast_status_page_component_group.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "squadcast_service.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "API"),
+					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageComponentConfig_ungrouped(statusPageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "service_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", "API"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccStatusPageChildImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageComponentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_status_page_component" {
+			continue
+		}
+
+		_, err := client.GetStatusPageComponentById(context.Background(), rs.Primary.Attributes["status_page_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected status page component to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStatusPageComponentConfig(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+
+resource "squadcast_status_page_component" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "API"
+}
+	`, statusPageName, statusPageName)
+}
+
+func testAccResourceStatusPageComponentConfig_update(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_service" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	escalation_policy_id = "61361415c2fc70c3101ca7db"
+	email_prefix = "%s"
+}
+
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+
+resource "squadcast_status_page_component_group" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "Backend"
+}
+
+resource "squadcast_status_page_component" "test" {
+	status_page_id = squadcast_status_page.test.id
+	group_id = squadcast_status_page_component_group.test.id
+	service_id = squadcast_service.test.id
+	name = "API"
+	description = "some description here."
+}
+	`, statusPageName, statusPageName, statusPageName, statusPageName)
+}
+
+func testAccResourceStatusPageComponentConfig_ungrouped(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+
+resource "squadcast_status_page_component_group" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "Backend"
+}
+
+resource "squadcast_status_page_component" "test" {
+	status_page_id = squadcast_status_page.test.id
+	name = "API"
+	description = "some description here."
+}
+	`, statusPageName, statusPageName)
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceStatusPage(t *testing.T) {
+	statusPageName := acctest.RandomWithPrefix("statuspage")
+
+	resourceName := "squadcast_status_page.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckStatusPageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceStatusPageConfig(statusPageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", statusPageName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", statusPageName),
+					resource.TestCheckResourceAttr(resourceName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "UTC"),
+					resource.TestCheckResourceAttrSet(resourceName, "url"),
+				),
+			},
+			{
+				Config: testAccResourceStatusPageConfig_update(statusPageName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", statusPageName),
+					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "Asia/Kolkata"),
+					resource.TestCheckResourceAttr(resourceName, "theme_color.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "theme_color.0.primary", "#0f61dd"),
+					resource.TestCheckResourceAttr(resourceName, "theme_color.0.secondary", "#ffffff"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "613611c1eb22db455cfa789f:",
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_status_page" {
+			continue
+		}
+
+		_, err := client.GetStatusPageById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected status page to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceStatusPageConfig(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+}
+	`, statusPageName, statusPageName)
+}
+
+func testAccResourceStatusPageConfig_update(statusPageName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_status_page" "test" {
+	name = "%s"
+	description = "some description here."
+	team_id = "613611c1eb22db455cfa789f"
+	domain_name = "%s"
+	timezone = "Asia/Kolkata"
+
+	theme_color {
+		primary = "#0f61dd"
+		secondary = "#ffffff"
+	}
+}
+	`, statusPageName, statusPageName)
+}
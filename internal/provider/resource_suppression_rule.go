@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// suppressionServiceRule describes the suppression rules of a service, see serviceRule.
+func suppressionServiceRule() *serviceRule[*api.SuppressionRule] {
+	return &serviceRule[*api.SuppressionRule]{
+		kind: "suppression_rules",
+		rule: resourceSuppressionRules().Schema["rules"].Elem.(*schema.Resource).Schema,
+		decode: func(ctx context.Context, client *api.Client, teamID string, m tf.M) (*api.SuppressionRule, error) {
+			var rule api.SuppressionRule
+			err := Decode(m, &rule)
+			return &rule, err
+		},
+		list: func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]*api.SuppressionRule, error) {
+			suppressionRules, err := client.GetSuppressionRules(ctx, serviceID, teamID)
+			if err != nil {
+				return nil, err
+			}
+			return suppressionRules.Rules, nil
+		},
+		update: func(ctx context.Context, client *api.Client, serviceID, teamID string, rules []*api.SuppressionRule) error {
+			req := &api.UpdateSuppressionRulesReq{Rules: make([]api.SuppressionRule, len(rules))}
+			for i, rule := range rules {
+				req.Rules[i] = *rule
+			}
+			_, err := client.UpdateSuppressionRules(ctx, serviceID, teamID, req)
+			return err
+		},
+	}
+}
+
+func resourceSuppressionRule() *schema.Resource {
+	return suppressionServiceRule().resource("A suppression rule of a service. Unlike `squadcast_suppression_rules`, which manages all the suppression rules of a service, this resource only manages one rule and keeps the other rules of the service, so several modules can add rules to the same service. " +
+		"Rules do not have an id, the rule is found in the rules of the service by its content: a rule changed outside of Terraform is considered removed, and a rule identical to another rule of the service cannot be created. Must not be used together with `squadcast_suppression_rules` for the same service.")
+}
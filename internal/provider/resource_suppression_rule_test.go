@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceSuppressionRule(t *testing.T) {
+	platform := "squadcast_suppression_rule.platform"
+	app := "squadcast_suppression_rule.app"
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckSuppressionRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSuppressionRuleConfig(0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(platform, "id"),
+					resource.TestCheckResourceAttr(platform, "priority", "0"),
+					resource.TestCheckResourceAttr(platform, "description", "platform"),
+					resource.TestCheckResourceAttr(app, "priority", "1"),
+					resource.TestCheckResourceAttr(app, "compiled_expression", "payload[\"team\"] == \"app\""),
+					testAccCheckSuppressionRulesCount(platform, 2),
+				),
+			},
+			{
+				Config: testAccResourceSuppressionRuleConfig(1, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(platform, "priority", "1"),
+					resource.TestCheckResourceAttr(app, "priority", "0"),
+					testAccCheckSuppressionRulesCount(platform, 2),
+				),
+			},
+			{
+				ResourceName:  platform,
+				ImportState:   true,
+				ImportStateId: "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd:1",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 state, got %d", len(states))
+					}
+					if got := states[0].Attributes["description"]; got != "platform" {
+						return fmt.Errorf("expected %s to be %s, got %s", "description", "platform", got)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSuppressionRulesCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*api.Client)
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		suppressionRules, err := client.GetSuppressionRules(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+		if count := len(suppressionRules.Rules); count != expected {
+			return fmt.Errorf("expected %d suppression rules, %d found", expected, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSuppressionRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_suppression_rule" {
+			continue
+		}
+
+		suppressionRules, err := client.GetSuppressionRules(context.Background(), rs.Primary.Attributes["service_id"], rs.Primary.Attributes["team_id"])
+		if err != nil {
+			return err
+		}
+		count := len(suppressionRules.Rules)
+		if count > 0 {
+			return fmt.Errorf("expected all suppression rules to be destroyed, %d found", count)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceSuppressionRuleConfig(platformPriority, appPriority int) string {
+	return fmt.Sprintf(`
+resource "squadcast_suppression_rule" "platform" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+	priority = %d
+
+		is_basic = false
+		description = "platform"
+		expression = "payload[\"env\"] == \"staging\""
+}
+
+resource "squadcast_suppression_rule" "app" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+	priority = %d
+
+		is_basic = true
+		description = "app"
+
+		basic_expressions {
+			lhs = "payload[\"team\"]"
+			op = "is"
+			rhs = "app"
+		}
+}
+	`, platformPriority, appPriority)
+}
@@ -2,17 +2,18 @@ package provider
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/mapstructure"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-const suppressionRulesID = "suppression_rules"
-
 func resourceSuppressionRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Suppression rules](https://support.squadcast.com/docs/alert-suppression) can help you avoid alert fatigue by suppressing notifications for non-actionable alerts." +
@@ -26,6 +27,7 @@ func resourceSuppressionRules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSuppressionRulesImport,
 		},
+		CustomizeDiff: resourceSuppressionRulesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -47,6 +49,8 @@ func resourceSuppressionRules() *schema.Resource {
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
+			"on_existing": onExistingSchema("suppression_rules"),
+			"rules_hash":  rulesHashSchema(),
 			"rules": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -63,9 +67,15 @@ func resourceSuppressionRules() *schema.Resource {
 							Optional:    true,
 						},
 						"expression": {
-							Description: "expression.",
+							Description:      "expression.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateExpression(expression.PayloadRoots...),
+						},
+						"compiled_expression": {
+							Description: "The advanced expression which is equivalent to the rule, compiled from `basic_expressions` when `is_basic` is true.",
 							Type:        schema.TypeString,
-							Optional:    true,
+							Computed:    true,
 						},
 						"basic_expressions": {
 							Description: "basic expression.",
@@ -80,9 +90,10 @@ func resourceSuppressionRules() *schema.Resource {
 										Required:    true,
 									},
 									"op": {
-										Description: "op",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "op, one of `" + strings.Join(expression.Ops, "`, `") + "`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(expression.Ops, false),
 									},
 									"rhs": {
 										Description: "rhs",
@@ -107,11 +118,16 @@ func resourceSuppressionRulesImport(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("team_id", teamID)
 	d.Set("service_id", serviceID)
-	d.SetId(suppressionRulesID)
+	d.Set("on_existing", onExistingOverwrite)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceSuppressionRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	return validateRulesBasicExpressions(d)
+}
+
 func Decode(input any, output any) error {
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		Result:               output,
@@ -140,17 +156,34 @@ func resourceSuppressionRulesCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	tflog.Info(ctx, "Creating suppression_rules", tf.M{
-		"team_id":    d.Get("team_id").(string),
-		"service_id": d.Get("service_id").(string),
-	})
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
 
-	_, err = client.UpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateSuppressionRulesReq{Rules: rules})
+	unlock := lockServiceRules("suppression_rules", serviceID)
+	defer unlock()
+
+	existing, err := client.GetSuppressionRules(ctx, serviceID, teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adopt, err := adoptServiceRules(ctx, d, "suppression_rules", len(existing.Rules))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(suppressionRulesID)
+	if !adopt {
+		tflog.Info(ctx, "Creating suppression_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+
+		_, err = client.UpdateSuppressionRules(ctx, serviceID, teamID, &api.UpdateSuppressionRulesReq{Rules: rules})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return resourceSuppressionRulesRead(ctx, d, meta)
 }
@@ -181,6 +214,16 @@ func resourceSuppressionRulesRead(ctx context.Context, d *schema.ResourceData, m
 	if err = tf.EncodeAndSet(suppressionRules, d); err != nil {
 		return diag.FromErr(err)
 	}
+	// The rules have the id of their document in the API, the resource is identified by its service instead.
+	d.SetId(serviceRulesID(teamID.(string), serviceID.(string)))
+
+	rulesHash, err := serviceRulesHash(suppressionRules.Rules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rules_hash", rulesHash); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -188,18 +231,12 @@ func resourceSuppressionRulesRead(ctx context.Context, d *schema.ResourceData, m
 func resourceSuppressionRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var rules []api.SuppressionRule
-	err := Decode(d.Get("rules"), &rules)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	_, err = client.UpdateSuppressionRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateSuppressionRulesReq{Rules: rules})
-	if err != nil {
-		return diag.FromErr(err)
+	diags := suppressionServiceRule().updateRules(ctx, client, d)
+	if diags.HasError() {
+		return diags
 	}
 
-	return resourceSuppressionRulesRead(ctx, d, meta)
+	return append(diags, resourceSuppressionRulesRead(ctx, d, meta)...)
 }
 
 func resourceSuppressionRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
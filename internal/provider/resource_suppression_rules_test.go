@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -20,11 +21,13 @@ func TestAccResourceSuppressionRules(t *testing.T) {
 			{
 				Config: testAccResourceSuppressionRulesConfig(),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules_hash"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "rules.*", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.is_basic", "false"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.description", "not basic"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.compiled_expression", "payload[\"event_id\"] == 40"),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
@@ -45,6 +48,7 @@ func TestAccResourceSuppressionRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.lhs", "payload[\"foo\"]"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.op", "is"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.rhs", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.compiled_expression", "payload[\"foo\"] == \"bar\""),
 					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
 					resource.TestCheckResourceAttr(resourceName, "service_id", "61361611c2fc70c3101ca7dd"),
 				),
@@ -59,6 +63,33 @@ func TestAccResourceSuppressionRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceSuppressionRules_onExisting(t *testing.T) {
+	resourceName := "squadcast_suppression_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckSuppressionRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSuppressionRulesConfig_existing(),
+			},
+			{
+				Config:      testAccResourceSuppressionRulesConfig_onExisting("fail"),
+				ExpectError: regexp.MustCompile(`service 61361611c2fc70c3101ca7dd already has 1 suppression rules`),
+			},
+			{
+				Config: testAccResourceSuppressionRulesConfig_onExisting("adopt"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "613611c1eb22db455cfa789f:61361611c2fc70c3101ca7dd"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.description", "not basic"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckSuppressionRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -120,3 +151,34 @@ resource "squadcast_suppression_rules" "test" {
 }
 	`)
 }
+
+func testAccResourceSuppressionRulesConfig_existing() string {
+	return fmt.Sprintf(`
+resource "squadcast_suppression_rules" "existing" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		description = "not basic"
+		expression = "payload[\"event_id\"] == 40"
+	}
+}
+	`)
+}
+
+func testAccResourceSuppressionRulesConfig_onExisting(onExisting string) string {
+	return testAccResourceSuppressionRulesConfig_existing() + fmt.Sprintf(`
+resource "squadcast_suppression_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+	on_existing = "%s"
+
+	rules {
+		is_basic = false
+		description = "adopted"
+		expression = "payload[\"event_id\"] == 41"
+	}
+}
+	`, onExisting)
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tags"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		Description: "A tag of the tag settings of the organization, e.g. `severity` with the values `critical`, `high` and `low`. " +
+			"The tags added by tagging rules are validated against the tags of the organization at plan time: keys and values which only differ by case from registered ones are rejected, " +
+			"as well as values and colors which are not allowed. With `strict_tags` in the provider configuration, tagging rules may only add registered tags.",
+
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Tag id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"key": {
+				Description:  "Key of the tag, unique regardless of case.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"values": {
+				Description: "Allowed values of the tag, any value is allowed when empty.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"colors": {
+				Description: "Allowed colors of the tag, hex values, any color is allowed when empty.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateHexColor,
+				},
+			},
+		},
+	}
+}
+
+func resourceTagImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+
+	tag, err := client.GetTagByKey(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(tag.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// checkTagKey checks that no other tag of the organization has the same key, regardless of case.
+func checkTagKey(ctx context.Context, client *api.Client, id, key string) error {
+	existing, err := client.ListTags(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range existing {
+		if t.ID != id && strings.EqualFold(t.Key, key) {
+			return fmt.Errorf("the tag %q already exists, keys which only differ by case are not allowed", t.Key)
+		}
+	}
+	return nil
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	if err := checkTagKey(ctx, client, "", d.Get("key").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating tag", tf.M{
+		"key": d.Get("key").(string),
+	})
+	tag, err := client.CreateTag(ctx, &api.CreateUpdateTagReq{
+		Key:    d.Get("key").(string),
+		Values: tf.ListToSlice[string](d.Get("values")),
+		Colors: tf.ListToSlice[string](d.Get("colors")),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(tag.ID)
+
+	return resourceTagRead(ctx, d, meta)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	tflog.Info(ctx, "Reading tag", tf.M{
+		"id":  d.Id(),
+		"key": d.Get("key").(string),
+	})
+	tag, err := client.GetTagById(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(tag, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	if d.HasChange("key") {
+		if err := checkTagKey(ctx, client, d.Id(), d.Get("key").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err := client.UpdateTag(ctx, d.Id(), &api.CreateUpdateTagReq{
+		Key:    d.Get("key").(string),
+		Values: tf.ListToSlice[string](d.Get("values")),
+		Colors: tf.ListToSlice[string](d.Get("colors")),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTagRead(ctx, d, meta)
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteTag(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// tagRegistry returns the registry of the tags of the organization, read from its tag settings.
+func tagRegistry(ctx context.Context, client *api.Client) (*tags.Registry, error) {
+	tflog.Info(ctx, "Reading tags", tf.M{})
+	settings, err := client.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := make([]*tags.Tag, len(settings))
+	for i, t := range settings {
+		registered[i] = &tags.Tag{Key: t.Key, Values: t.Values, Colors: t.Colors}
+	}
+
+	registry, err := tags.NewRegistry(registered, client.StrictTags)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag settings: %s", err.Error())
+	}
+	return registry, nil
+}
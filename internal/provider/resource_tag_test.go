@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceTag(t *testing.T) {
+	tagKey := acctest.RandomWithPrefix("severity")
+
+	resourceName := "squadcast_tag.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagConfig(tagKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", tagKey),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "values.1", "high"),
+					resource.TestCheckResourceAttr(resourceName, "colors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "colors.0", "#ff0000"),
+				),
+			},
+			{
+				Config: testAccResourceTagConfig_update(tagKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", tagKey),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "values.2", "low"),
+					resource.TestCheckResourceAttr(resourceName, "colors.#", "0"),
+				),
+			},
+			{
+				Config:      testAccResourceTagConfig_update(tagKey) + testAccResourceTagConfig_duplicate(strings.ToUpper(tagKey)),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`the tag "%s" already exists, keys which only differ by case are not allowed`, tagKey)),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     tagKey,
+			},
+		},
+	})
+}
+
+func testAccCheckTagDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_tag" {
+			continue
+		}
+
+		_, err := client.GetTagById(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected tag to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceTagConfig(tagKey string) string {
+	return fmt.Sprintf(`
+resource "squadcast_tag" "test" {
+	key = "%s"
+	values = ["critical", "high"]
+	colors = ["#ff0000"]
+}
+	`, tagKey)
+}
+
+func testAccResourceTagConfig_update(tagKey string) string {
+	return fmt.Sprintf(`
+resource "squadcast_tag" "test" {
+	key = "%s"
+	values = ["critical", "high", "low"]
+}
+	`, tagKey)
+}
+
+func testAccResourceTagConfig_duplicate(tagKey string) string {
+	return fmt.Sprintf(`
+resource "squadcast_tag" "duplicate" {
+	key = "%s"
+	depends_on = [squadcast_tag.test]
+}
+	`, tagKey)
+}
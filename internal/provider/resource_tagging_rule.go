@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+// taggingServiceRule describes the tagging rules of a service, see serviceRule.
+func taggingServiceRule() *serviceRule[*api.TaggingRule] {
+	return &serviceRule[*api.TaggingRule]{
+		kind: "tagging_rules",
+		rule: resourceTaggingRules().Schema["rules"].Elem.(*schema.Resource).Schema,
+		decode: func(ctx context.Context, client *api.Client, teamID string, m tf.M) (*api.TaggingRule, error) {
+			var rules []api.TaggingRule
+			if err := decodeTaggingRules([]any{m}, &rules); err != nil {
+				return nil, err
+			}
+			return &rules[0], nil
+		},
+		list: func(ctx context.Context, client *api.Client, serviceID, teamID string) ([]*api.TaggingRule, error) {
+			taggingRules, err := client.GetTaggingRules(ctx, serviceID, teamID)
+			if err != nil {
+				return nil, err
+			}
+			return taggingRules.Rules, nil
+		},
+		update: func(ctx context.Context, client *api.Client, serviceID, teamID string, rules []*api.TaggingRule) error {
+			req := &api.UpdateTaggingRulesReq{Rules: make([]api.TaggingRule, len(rules))}
+			for i, rule := range rules {
+				req.Rules[i] = *rule
+			}
+			_, err := client.UpdateTaggingRules(ctx, serviceID, teamID, req)
+			return err
+		},
+	}
+}
+
+func resourceTaggingRule() *schema.Resource {
+	r := taggingServiceRule().resource("A tagging rule of a service. Unlike `squadcast_tagging_rules`, which manages all the tagging rules of a service, this resource only manages one rule and keeps the other rules of the service, so several modules can add rules to the same service. " +
+		"Rules do not have an id, the rule is found in the rules of the service by its content: a rule changed outside of Terraform is considered removed, and a rule identical to another rule of the service cannot be created. Must not be used together with `squadcast_tagging_rules` for the same service.")
+	r.CustomizeDiff = customdiff.All(r.CustomizeDiff, resourceTaggingRuleCustomizeDiff)
+
+	return r
+}
+
+func resourceTaggingRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	client := meta.(*api.Client)
+
+	mtags := d.GetRawConfig().GetAttr("tags")
+	if !mtags.IsKnown() || mtags.IsNull() || mtags.LengthInt() == 0 {
+		return nil
+	}
+	registry, err := tagRegistry(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if violations := validateTaggingRuleTags(registry, mtags, ""); len(violations) > 0 {
+		return fmt.Errorf("tagging rule adds tags which are not allowed:\n%s", strings.Join(violations, "\n"))
+	}
+
+	if !isExclusiveTaggingRule(d.GetRawConfig()) {
+		return nil
+	}
+	if !d.NewValueKnown("team_id") || !d.NewValueKnown("service_id") || !d.NewValueKnown("priority") {
+		return nil
+	}
+	previous, err := previousTaggingRuleKeys(ctx, client, d)
+	if err != nil {
+		return err
+	}
+	keys := taggingRuleTagKeys(mtags)
+	if conflicts := tagKeyConflicts(previous, keys); len(conflicts) > 0 {
+		violations := make([]string, len(conflicts))
+		for i, c := range conflicts {
+			violations[i] = fmt.Sprintf("tags.%d: tag %q is already added by the rule at priority %d of the service, the mode of the rule is `exclusive`", c.tag, keys[c.tag], c.rule)
+		}
+		return fmt.Errorf("tagging rule adds tags which are already added by previous rules of the service:\n%s", strings.Join(violations, "\n"))
+	}
+
+	return nil
+}
+
+// previousTaggingRuleKeys returns the keys of the tags of the tagging rules of the service which will be before the rule once it is written.
+func previousTaggingRuleKeys(ctx context.Context, client *api.Client, d *schema.ResourceDiff) ([][]string, error) {
+	s := taggingServiceRule()
+	teamID := d.Get("team_id").(string)
+
+	rules, err := s.list(ctx, client, d.Get("service_id").(string), teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Id() != "" {
+		m := tf.M{}
+		for k := range s.rule {
+			o, _ := d.GetChange(k)
+			m[k] = o
+		}
+		old, err := s.decode(ctx, client, teamID, m)
+		if err != nil {
+			return nil, err
+		}
+		i, err := s.find(rules, old)
+		if err != nil {
+			return nil, err
+		}
+		if i >= 0 {
+			rules = append(rules[:i:i], rules[i+1:]...)
+		}
+	}
+
+	priority := d.Get("priority").(int)
+	if priority > len(rules) {
+		priority = len(rules)
+	}
+
+	previous := make([][]string, priority)
+	for i, rule := range rules[:priority] {
+		for key := range rule.Tags {
+			previous[i] = append(previous[i], key)
+		}
+	}
+	return previous, nil
+}
@@ -2,16 +2,20 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tags"
 	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
 )
 
-const taggingRulesID = "tagging_rules"
-
 func resourceTaggingRules() *schema.Resource {
 	return &schema.Resource{
 		Description: "[Tagging](https://support.squadcast.com/docs/event-tagging) is a rule-based, auto-tagging system with which you can define customised tags based on incident payloads, that get automatically assigned to incidents when they are triggered.",
@@ -23,6 +27,7 @@ func resourceTaggingRules() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceTaggingRulesImport,
 		},
+		CustomizeDiff: resourceTaggingRulesCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -44,6 +49,8 @@ func resourceTaggingRules() *schema.Resource {
 				ValidateFunc: tf.ValidateObjectID,
 				ForceNew:     true,
 			},
+			"on_existing": onExistingSchema("tagging_rules"),
+			"rules_hash":  rulesHashSchema(),
 			"rules": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -55,9 +62,15 @@ func resourceTaggingRules() *schema.Resource {
 							Required:    true,
 						},
 						"expression": {
-							Description: "expression.",
+							Description:      "expression.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateExpression(expression.PayloadRoots...),
+						},
+						"compiled_expression": {
+							Description: "The advanced expression which is equivalent to the rule, compiled from `basic_expressions` when `is_basic` is true.",
 							Type:        schema.TypeString,
-							Optional:    true,
+							Computed:    true,
 						},
 						"basic_expressions": {
 							Description: "basic expression.",
@@ -72,9 +85,10 @@ func resourceTaggingRules() *schema.Resource {
 										Required:    true,
 									},
 									"op": {
-										Description: "op",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "op, one of `" + strings.Join(expression.Ops, "`, `") + "`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(expression.Ops, false),
 									},
 									"rhs": {
 										Description: "rhs",
@@ -97,18 +111,31 @@ func resourceTaggingRules() *schema.Resource {
 										Required:    true,
 									},
 									"value": {
-										Description: "value",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description: "Value of the tag. It may interpolate fields of the payload of the alert, e.g. `{{ payload.labels.instance }}`, " +
+											"with a default value when the field does not exist, e.g. `{{ payload.labels.instance | default \"unknown\" }}`.",
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validateTagTemplate,
 									},
 									"color": {
-										Description: "Tag color, hex values",
-										Type:        schema.TypeString,
-										Required:    true,
+										Description:  "Tag color, hex values",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: tf.ValidateHexColor,
 									},
 								},
 							},
 						},
+						"mode": {
+							Description: "How the tags of the rule combine with the tags of previous rules with the same keys. " +
+								"With `override`, the tags of the rule replace them when both rules match, the last matching rule wins. " +
+								"With `exclusive`, a key which is already added by a previous rule is rejected at plan time. " +
+								"There is no `append` mode, an incident has a single value per tag key. It is not stored in the API.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      taggingRuleModeOverride,
+							ValidateFunc: validation.StringInSlice(taggingRuleModes, false),
+						},
 					},
 				},
 			},
@@ -124,25 +151,161 @@ func resourceTaggingRulesImport(ctx context.Context, d *schema.ResourceData, met
 
 	d.Set("team_id", teamID)
 	d.Set("service_id", serviceID)
-	d.SetId(taggingRulesID)
+	d.Set("on_existing", onExistingOverwrite)
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return []*schema.ResourceData{d}, nil
 }
 
-// func decodeTaggingRules(input []any, output *[]api.TaggingRule) error {}
-
-func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+func resourceTaggingRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 	client := meta.(*api.Client)
 
-	mrules := d.Get("rules").([]any)
-	var rules []api.TaggingRule
-	err := Decode(mrules, &rules)
+	if err := validateRulesBasicExpressions(d); err != nil {
+		return err
+	}
+
+	rules := d.GetRawConfig().GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	if rules.LengthInt() == 0 {
+		return nil
+	}
+	if violations := validateTaggingRuleModes(rules.AsValueSlice()); len(violations) > 0 {
+		return fmt.Errorf("tagging rules add tags which are already added by previous rules:\n%s", strings.Join(violations, "\n"))
+	}
+
+	registry, err := tagRegistry(ctx, client)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	for i, mrule := range mrules {
+	violations := make([]string, 0)
+	for i, rule := range rules.AsValueSlice() {
+		violations = append(violations, validateTaggingRuleTags(registry, rule.GetAttr("tags"), fmt.Sprintf("rules.%d.", i))...)
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("tagging rules add tags which are not allowed:\n%s", strings.Join(violations, "\n"))
+	}
 
+	return nil
+}
+
+// taggingRuleTagKeys returns the keys of the tags of a tagging rule, in order, with an empty key for the keys which are not known yet.
+func taggingRuleTagKeys(mtags cty.Value) []string {
+	if !mtags.IsKnown() || mtags.IsNull() {
+		return nil
+	}
+
+	keys := make([]string, 0, mtags.LengthInt())
+	for _, mtag := range mtags.AsValueSlice() {
+		key := mtag.GetAttr("key")
+		if !key.IsKnown() || key.IsNull() {
+			keys = append(keys, "")
+			continue
+		}
+		keys = append(keys, key.AsString())
+	}
+	return keys
+}
+
+// The modes of tagging rules, which are only used by the provider.
+const (
+	taggingRuleModeOverride  = "override"
+	taggingRuleModeExclusive = "exclusive"
+)
+
+var taggingRuleModes = []string{taggingRuleModeOverride, taggingRuleModeExclusive}
+
+// isExclusiveTaggingRule returns true when the mode of a tagging rule is known to be `exclusive`.
+func isExclusiveTaggingRule(rule cty.Value) bool {
+	mode := rule.GetAttr("mode")
+	return mode.IsKnown() && !mode.IsNull() && mode.AsString() == taggingRuleModeExclusive
+}
+
+// validateTaggingRuleModes checks that the tags of the tagging rules whose mode is `exclusive` do not have the same keys as the tags of previous rules.
+func validateTaggingRuleModes(rules []cty.Value) []string {
+	violations := make([]string, 0)
+	previous := make([][]string, 0, len(rules))
+	for i, rule := range rules {
+		keys := taggingRuleTagKeys(rule.GetAttr("tags"))
+		if isExclusiveTaggingRule(rule) {
+			for _, c := range tagKeyConflicts(previous, keys) {
+				violations = append(violations, fmt.Sprintf("rules.%d.tags.%d: tag %q is already added by rules.%d, the mode of the rule is `exclusive`", i, c.tag, keys[c.tag], c.rule))
+			}
+		}
+		previous = append(previous, keys)
+	}
+	return violations
+}
+
+// tagKeyConflict is a tag whose key is already added by a previous rule.
+type tagKeyConflict struct {
+	// tag is the index of the tag, rule the index of the first previous rule which adds its key.
+	tag, rule int
+}
+
+// tagKeyConflicts returns the tags, given by their keys, whose keys are already added by the previous rules. Empty keys are not known yet and are ignored.
+func tagKeyConflicts(previous [][]string, keys []string) []tagKeyConflict {
+	conflicts := make([]tagKeyConflict, 0)
+	for i, key := range keys {
+		if key == "" {
+			continue
+		}
+	previous:
+		for p, previousKeys := range previous {
+			for _, previousKey := range previousKeys {
+				if previousKey == key {
+					conflicts = append(conflicts, tagKeyConflict{tag: i, rule: p})
+					break previous
+				}
+			}
+		}
+	}
+	return conflicts
+}
+
+// keepTaggingRuleModes copies `mode`, which is not stored in the API, from the rules in the state to the rules read from the API.
+// Rules which are not in the state, e.g. imported ones, have the default mode.
+func keepTaggingRuleModes(prevRules []tf.M, rules []any) {
+	for i, rule := range rules {
+		mode := taggingRuleModeOverride
+		if i < len(prevRules) {
+			if prev, _ := prevRules[i]["mode"].(string); prev != "" {
+				mode = prev
+			}
+		}
+		rule.(tf.M)["mode"] = mode
+	}
+}
+
+// validateTaggingRuleTags validates the tags of a tagging rule against the tags of the organization. Tags which are not known yet are not validated.
+func validateTaggingRuleTags(registry *tags.Registry, mtags cty.Value, prefix string) []string {
+	if !mtags.IsKnown() || mtags.IsNull() {
+		return nil
+	}
+
+	violations := make([]string, 0)
+	for i, mtag := range mtags.AsValueSlice() {
+		key, value, color := mtag.GetAttr("key"), mtag.GetAttr("value"), mtag.GetAttr("color")
+		if !key.IsKnown() || key.IsNull() || !value.IsKnown() || value.IsNull() || !color.IsKnown() || color.IsNull() {
+			continue
+		}
+		if err := registry.Validate(key.AsString(), value.AsString(), color.AsString()); err != nil {
+			violations = append(violations, fmt.Sprintf("%stags.%d: %s", prefix, i, err))
+		}
+	}
+	return violations
+}
+
+func decodeTaggingRules(input []any, output *[]api.TaggingRule) error {
+	err := Decode(input, output)
+	if err != nil {
+		return err
+	}
+
+	for i, mrule := range input {
 		mtags := mrule.(tf.M)["tags"].([]any)
 
 		tags := make(map[string]api.TaggingRuleTagValue, len(mtags))
@@ -151,7 +314,7 @@ func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 			var tagvalue api.TaggingRuleTagValue
 			err := Decode(mtag, &tagvalue)
 			if err != nil {
-				return diag.FromErr(err)
+				return err
 			}
 
 			key := mtag.(tf.M)["key"].(string)
@@ -159,20 +322,49 @@ func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, met
 			tags[key] = tagvalue
 		}
 
-		rules[i].Tags = tags
+		(*output)[i].Tags = tags
 	}
 
-	tflog.Info(ctx, "Creating tagging_rules", tf.M{
-		"team_id":    d.Get("team_id").(string),
-		"service_id": d.Get("service_id").(string),
-	})
+	return nil
+}
+
+func resourceTaggingRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var rules []api.TaggingRule
+	err := decodeTaggingRules(d.Get("rules").([]any), &rules)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	teamID := d.Get("team_id").(string)
+	serviceID := d.Get("service_id").(string)
+
+	unlock := lockServiceRules("tagging_rules", serviceID)
+	defer unlock()
 
-	_, err = client.UpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateTaggingRulesReq{Rules: rules})
+	existing, err := client.GetTaggingRules(ctx, serviceID, teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adopt, err := adoptServiceRules(ctx, d, "tagging_rules", len(existing.Rules))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(taggingRulesID)
+	if !adopt {
+		tflog.Info(ctx, "Creating tagging_rules", tf.M{
+			"team_id":    teamID,
+			"service_id": serviceID,
+		})
+
+		_, err = client.UpdateTaggingRules(ctx, serviceID, teamID, &api.UpdateTaggingRulesReq{Rules: rules})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(serviceRulesID(teamID, serviceID))
 
 	return resourceTaggingRulesRead(ctx, d, meta)
 }
@@ -200,50 +392,38 @@ func resourceTaggingRulesRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
-	if err = tf.EncodeAndSet(taggingRules, d); err != nil {
+	m, err := taggingRules.Encode()
+	if err != nil {
 		return diag.FromErr(err)
 	}
+	keepTaggingRuleModes(tf.ListToSlice[tf.M](d.Get("rules")), m["rules"].([]any))
 
-	return nil
-}
-
-func resourceTaggingRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	client := meta.(*api.Client)
+	if err = tf.SetState(d, m); err != nil {
+		return diag.FromErr(err)
+	}
+	// The rules have the id of their document in the API, the resource is identified by its service instead.
+	d.SetId(serviceRulesID(teamID.(string), serviceID.(string)))
 
-	mrules := d.Get("rules").([]any)
-	var rules []api.TaggingRule
-	err := Decode(mrules, &rules)
+	rulesHash, err := serviceRulesHash(taggingRules.Rules)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("rules_hash", rulesHash); err != nil {
+		return diag.FromErr(err)
+	}
 
-	for i, mrule := range mrules {
-
-		mtags := mrule.(tf.M)["tags"].([]any)
-
-		tags := make(map[string]api.TaggingRuleTagValue, len(mtags))
-
-		for _, mtag := range mtags {
-			var tagvalue api.TaggingRuleTagValue
-			err := Decode(mtag, &tagvalue)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			key := mtag.(tf.M)["key"].(string)
-
-			tags[key] = tagvalue
-		}
+	return nil
+}
 
-		rules[i].Tags = tags
-	}
+func resourceTaggingRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
 
-	_, err = client.UpdateTaggingRules(ctx, d.Get("service_id").(string), d.Get("team_id").(string), &api.UpdateTaggingRulesReq{Rules: rules})
-	if err != nil {
-		return diag.FromErr(err)
+	diags := taggingServiceRule().updateRules(ctx, client, d)
+	if diags.HasError() {
+		return diags
 	}
 
-	return resourceTaggingRulesRead(ctx, d, meta)
+	return append(diags, resourceTaggingRulesRead(ctx, d, meta)...)
 }
 
 func resourceTaggingRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -3,8 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -34,6 +37,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.is_basic", "false"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", "payload[\"event_id\"] == 40"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.compiled_expression", "payload[\"event_id\"] == 40"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.0.key", "MyTag"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.tags.0.value", "foo"),
@@ -60,6 +64,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.lhs", "payload[\"foo\"]"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.op", "is"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.basic_expressions.0.rhs", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.compiled_expression", "payload[\"foo\"] == \"bar\""),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.0.key", "MyTag"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.0.value", "foo"),
@@ -67,6 +72,7 @@ func TestAccResourceTaggingRules(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.key", "MyTag2"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.value", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "rules.1.tags.1.color", "#f0f0f0"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.mode", "override"),
 					resource.TestCheckResourceAttrPair(resourceName, "team_id", teamResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "service_id", serviceResourceName, "id"),
 				),
@@ -94,6 +100,65 @@ func TestAccResourceTaggingRules(t *testing.T) {
 	})
 }
 
+func TestAccResourceTaggingRules_tagRegistry(t *testing.T) {
+	tagKey := acctest.RandomWithPrefix("severity")
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckTagDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The tags are read from the API at plan time, so the tag is created first.
+				Config: testAccResourceTagConfig(tagKey),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, false, strings.ToUpper(tagKey), "high", "#ff0000"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`rules.0.tags.0: tag "%s" is not registered, did you mean "%s"\?`, strings.ToUpper(tagKey), tagKey)),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, false, tagKey, "medium", "#ff0000"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`value of tag "%s" "medium" is not allowed, expected one of "critical", "high"`, tagKey)),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, false, tagKey, "high", "#00ff00"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`color of tag "%s" "#00ff00" is not allowed, expected one of "#ff0000"`, tagKey)),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, true, "env", "prod", "#00ff00"),
+				ExpectError: regexp.MustCompile(`tag "env" is not registered, expected one of .*"` + tagKey + `"`),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, false, tagKey, "high", "red"),
+				ExpectError: regexp.MustCompile(`must be a hex color`),
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_tag(tagKey, false, "host", "{{ payload.host", "#00ff00"),
+				ExpectError: regexp.MustCompile("column 1: unclosed `{{`, expected `}}`"),
+			},
+			{
+				Config:             testAccResourceTaggingRulesConfig_tag(tagKey, false, tagKey, "{{ payload.severity }}", "#ff0000"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:             testAccResourceTaggingRulesConfig_tag(tagKey, false, "env", "prod", "#00ff00"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      testAccResourceTaggingRulesConfig_mode(tagKey, "exclusive"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("rules.1.tags.0: tag \"%s\" is already added by rules.0, the mode of the rule is `exclusive`", tagKey)),
+			},
+			{
+				Config:             testAccResourceTaggingRulesConfig_mode(tagKey, "override"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckTaggingRulesDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*api.Client)
 
@@ -258,3 +323,139 @@ resource "squadcast_tagging_rules" "test" {
 }
 	`, teamName, user.FirstName, user.LastName, user.Email, epName, serviceName)
 }
+
+func testAccResourceTaggingRulesConfig_tag(tagKey string, strict bool, key, value, color string) string {
+	return fmt.Sprintf(`
+provider "squadcast" {
+	strict_tags = %t
+}
+
+resource "squadcast_tag" "test" {
+	key = "%s"
+	values = ["critical", "high"]
+	colors = ["#ff0000"]
+}
+
+resource "squadcast_tagging_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "payload.severity == \"critical\""
+
+		tags {
+			key = "%s"
+			value = "%s"
+			color = "%s"
+		}
+	}
+}
+	`, strict, tagKey, key, value, color)
+}
+
+func testAccResourceTaggingRulesConfig_mode(tagKey, mode string) string {
+	return fmt.Sprintf(`
+resource "squadcast_tag" "test" {
+	key = "%s"
+	values = ["critical", "high"]
+	colors = ["#ff0000"]
+}
+
+resource "squadcast_tagging_rules" "test" {
+	team_id = "613611c1eb22db455cfa789f"
+	service_id = "61361611c2fc70c3101ca7dd"
+
+	rules {
+		is_basic = false
+		expression = "payload.severity == \"high\""
+
+		tags {
+			key = "%s"
+			value = "high"
+			color = "#ff0000"
+		}
+	}
+
+	rules {
+		is_basic = false
+		expression = "payload.severity == \"critical\""
+		mode = "%s"
+
+		tags {
+			key = "%s"
+			value = "critical"
+			color = "#ff0000"
+		}
+	}
+}
+	`, tagKey, tagKey, mode, tagKey)
+}
+
+func TestValidateTaggingRuleModes(t *testing.T) {
+	rule := func(mode cty.Value, keys ...cty.Value) cty.Value {
+		mtags := make([]cty.Value, len(keys))
+		for i, key := range keys {
+			mtags[i] = cty.ObjectVal(map[string]cty.Value{"key": key})
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"mode": mode,
+			"tags": cty.ListVal(mtags),
+		})
+	}
+	unset := cty.NullVal(cty.String)
+	exclusive := cty.StringVal("exclusive")
+
+	cases := []struct {
+		name  string
+		rules []cty.Value
+		want  []string
+	}{
+		{
+			name:  "same key with the default mode",
+			rules: []cty.Value{rule(unset, cty.StringVal("severity")), rule(unset, cty.StringVal("severity"))},
+		},
+		{
+			name:  "same key with override",
+			rules: []cty.Value{rule(unset, cty.StringVal("severity")), rule(cty.StringVal("override"), cty.StringVal("severity"))},
+		},
+		{
+			name:  "distinct keys with exclusive",
+			rules: []cty.Value{rule(unset, cty.StringVal("severity")), rule(exclusive, cty.StringVal("host"))},
+		},
+		{
+			name:  "same key with exclusive",
+			rules: []cty.Value{rule(unset, cty.StringVal("severity")), rule(unset, cty.StringVal("host")), rule(exclusive, cty.StringVal("host"), cty.StringVal("severity"))},
+			want: []string{
+				"rules.2.tags.0: tag \"host\" is already added by rules.1, the mode of the rule is `exclusive`",
+				"rules.2.tags.1: tag \"severity\" is already added by rules.0, the mode of the rule is `exclusive`",
+			},
+		},
+		{
+			name:  "exclusive first rule",
+			rules: []cty.Value{rule(exclusive, cty.StringVal("severity")), rule(unset, cty.StringVal("severity"))},
+		},
+		{
+			name:  "unknown mode",
+			rules: []cty.Value{rule(unset, cty.StringVal("severity")), rule(cty.UnknownVal(cty.String), cty.StringVal("severity"))},
+		},
+		{
+			name:  "unknown key",
+			rules: []cty.Value{rule(unset, cty.UnknownVal(cty.String)), rule(exclusive, cty.UnknownVal(cty.String))},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := validateTaggingRuleModes(c.rules)
+			if len(got) != len(c.want) {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+			for i := range got {
+				if got[i] != c.want[i] {
+					t.Fatalf("expected %q, got %q", c.want, got)
+				}
+			}
+		})
+	}
+}
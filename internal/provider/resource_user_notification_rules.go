@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceUserNotificationRules() *schema.Resource {
+	return &schema.Resource{
+		Description: "`squadcast_user_notification_rules` manages how a user is notified when an incident is assigned to them, and how they are reminded before their on-call shift starts. The resource owns all the rules of the user, rules added outside of terraform are removed on apply.",
+
+		CreateContext: resourceUserNotificationRulesCreate,
+		ReadContext:   resourceUserNotificationRulesRead,
+		UpdateContext: resourceUserNotificationRulesUpdate,
+		DeleteContext: resourceUserNotificationRulesDelete,
+		CustomizeDiff: resourceUserNotificationRulesCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserNotificationRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description:  "User id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"notification_rules": {
+				Description: "Personal notification rules, ordered by delay_minutes.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Notification channel. Supported values are \"Email\", \"Push\", \"SMS\" and \"Phone\"",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(api.PersonalNotificationRuleTypes, false),
+						},
+						"delay_minutes": {
+							Description:  "Minutes after the incident is assigned to the user after which the notification is sent.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1440),
+						},
+					},
+				},
+			},
+			"oncall_reminder_rules": {
+				Description: "On-call reminder rules, ordered by delay_minutes.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Notification channel. Supported values are \"Email\" and \"Push\"",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(api.OncallReminderRuleTypes, false),
+						},
+						"delay_minutes": {
+							Description:  "Minutes before the on-call shift starts at which the reminder is sent.",
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 10080),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceUserNotificationRulesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+	email := d.Id()
+
+	user, err := client.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", user.ID)
+	d.SetId(user.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// validateNotificationRulesOrder checks that the rules are listed in ascending order of delay_minutes,
+// and that the same channel is not used twice with the same delay.
+func validateNotificationRulesOrder(key string, rules []tf.M) error {
+	seen := map[string]bool{}
+
+	for i, rule := range rules {
+		delay := rule["delay_minutes"].(int)
+		if i > 0 && delay < rules[i-1]["delay_minutes"].(int) {
+			return fmt.Errorf("%s.%d: delay_minutes (%d) must not be lower than the delay_minutes of the previous rule (%d), rules must be listed in ascending order of delay", key, i, delay, rules[i-1]["delay_minutes"].(int))
+		}
+
+		k := fmt.Sprintf("%s:%d", strings.ToLower(rule["type"].(string)), delay)
+		if seen[k] {
+			return fmt.Errorf("%s.%d: duplicate rule, a %s notification is already sent after %d minutes", key, i, rule["type"].(string), delay)
+		}
+		seen[k] = true
+	}
+
+	return nil
+}
+
+func resourceUserNotificationRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, key := range []string{"notification_rules", "oncall_reminder_rules"} {
+		if err := validateNotificationRulesOrder(key, tf.ListToSlice[tf.M](d.Get(key))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeUserNotificationRules(d *schema.ResourceData) (*api.UpdateUserNotificationRulesReq, error) {
+	req := &api.UpdateUserNotificationRulesReq{
+		PersonalNotificationRules: []*api.PersonalNotificationRule{},
+		OncallReminderRules:       []*api.OncallReminderRule{},
+	}
+
+	err := Decode(d.Get("notification_rules"), &req.PersonalNotificationRules)
+	if err != nil {
+		return nil, err
+	}
+
+	err = Decode(d.Get("oncall_reminder_rules"), &req.OncallReminderRules)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func resourceUserNotificationRulesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeUserNotificationRules(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating user_notification_rules", tf.M{
+		"user_id": d.Get("user_id").(string),
+	})
+	_, err = client.UpdateUserNotificationRules(ctx, d.Get("user_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("user_id").(string))
+
+	return resourceUserNotificationRulesRead(ctx, d, meta)
+}
+
+func resourceUserNotificationRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	userID, ok := d.GetOk("user_id")
+	if !ok {
+		return diag.Errorf("invalid user id provided")
+	}
+
+	tflog.Info(ctx, "Reading user_notification_rules", tf.M{
+		"id":      d.Id(),
+		"user_id": userID.(string),
+	})
+	rules, err := client.GetUserNotificationRules(ctx, userID.(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(rules, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserNotificationRulesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeUserNotificationRules(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateUserNotificationRules(ctx, d.Get("user_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserNotificationRulesRead(ctx, d, meta)
+}
+
+func resourceUserNotificationRulesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.UpdateUserNotificationRules(ctx, d.Get("user_id").(string), &api.UpdateUserNotificationRulesReq{
+		PersonalNotificationRules: []*api.PersonalNotificationRule{},
+		OncallReminderRules:       []*api.OncallReminderRule{},
+	})
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestAccResourceUserNotificationRules(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resourceName := "squadcast_user_notification_rules.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserNotificationRulesConfig(user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.type", "Push"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.delay_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.1.type", "SMS"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.1.delay_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.2.type", "Phone"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.2.delay_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "oncall_reminder_rules.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceUserNotificationRulesConfig_update(user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.type", "Email"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.0.delay_minutes", "0"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.1.type", "Phone"),
+					resource.TestCheckResourceAttr(resourceName, "notification_rules.1.delay_minutes", "2"),
+					resource.TestCheckResourceAttr(resourceName, "oncall_reminder_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oncall_reminder_rules.0.type", "Email"),
+					resource.TestCheckResourceAttr(resourceName, "oncall_reminder_rules.0.delay_minutes", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     user.Email,
+			},
+		},
+	})
+}
+
+func TestAccResourceUserNotificationRulesOrder(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUserNotificationRulesConfig_unordered(user),
+				ExpectError: regexp.MustCompile("rules must be listed in ascending order of delay"),
+			},
+		},
+	})
+}
+
+func testAccResourceUserNotificationRulesConfig(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_notification_rules" "test" {
+	user_id = squadcast_user.test.id
+
+	notification_rules {
+		type = "Push"
+		delay_minutes = 0
+	}
+
+	notification_rules {
+		type = "SMS"
+		delay_minutes = 5
+	}
+
+	notification_rules {
+		type = "Phone"
+		delay_minutes = 10
+	}
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
+
+func testAccResourceUserNotificationRulesConfig_update(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_notification_rules" "test" {
+	user_id = squadcast_user.test.id
+
+	notification_rules {
+		type = "Email"
+		delay_minutes = 0
+	}
+
+	notification_rules {
+		type = "Phone"
+		delay_minutes = 2
+	}
+
+	oncall_reminder_rules {
+		type = "Email"
+		delay_minutes = 60
+	}
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
+
+func testAccResourceUserNotificationRulesConfig_unordered(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_notification_rules" "test" {
+	user_id = squadcast_user.test.id
+
+	notification_rules {
+		type = "Phone"
+		delay_minutes = 10
+	}
+
+	notification_rules {
+		type = "Push"
+		delay_minutes = 0
+	}
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceUserProfile() *schema.Resource {
+	return &schema.Resource{
+		Description: "`squadcast_user_profile` manages the contact details and profile of a `squadcast_user`. " +
+			"A user has to verify a new phone number from the Squadcast app before SMS and phone notifications can be sent to it. " +
+			"Destroying this resource does not change the profile of the user, it only removes it from the terraform state.",
+
+		CreateContext: resourceUserProfileCreate,
+		ReadContext:   resourceUserProfileRead,
+		UpdateContext: resourceUserProfileUpdate,
+		DeleteContext: resourceUserProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserProfileImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"user_id": {
+				Description:  "User id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"phone": {
+				Description:  "User phone number in E.164 format, e.g. `+14155552671`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: tf.ValidatePhoneNumber,
+			},
+			"time_zone": {
+				Description:  "IANA time zone of the user, e.g. `Asia/Kolkata`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tf.ValidateTimeZone,
+			},
+			"title": {
+				Description:  "User job title.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"bio": {
+				Description:  "User bio.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"is_email_verified": {
+				Description: "Denotes if the user has verified their email or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"is_phone_verified": {
+				Description: "Denotes if the user has verified their phone number or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceUserProfileImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+	email := d.Id()
+
+	user, err := client.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	d.Set("user_id", user.ID)
+	d.SetId(user.ID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeUserProfile(d *schema.ResourceData) (*api.UpdateUserProfileReq, error) {
+	req := &api.UpdateUserProfileReq{
+		TimeZone: d.Get("time_zone").(string),
+		Title:    d.Get("title").(string),
+		Bio:      d.Get("bio").(string),
+		Contact:  &api.Contact{},
+	}
+
+	if phone := d.Get("phone").(string); phone != "" {
+		dialCode, phoneNumber, err := api.SplitPhoneNumber(phone)
+		if err != nil {
+			return nil, err
+		}
+		req.Contact.DialCode = dialCode
+		req.Contact.PhoneNumber = phoneNumber
+	}
+
+	return req, nil
+}
+
+func resourceUserProfileCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeUserProfile(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating user_profile", tf.M{
+		"user_id": d.Get("user_id").(string),
+	})
+	_, err = client.UpdateUserProfile(ctx, d.Get("user_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("user_id").(string))
+
+	return resourceUserProfileRead(ctx, d, meta)
+}
+
+func resourceUserProfileRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	userID, ok := d.GetOk("user_id")
+	if !ok {
+		return diag.Errorf("invalid user id provided")
+	}
+
+	tflog.Info(ctx, "Reading user_profile", tf.M{
+		"id":      d.Id(),
+		"user_id": userID.(string),
+	})
+	profile, err := client.GetUserProfile(ctx, userID.(string))
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(profile, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUserProfileUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeUserProfile(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateUserProfile(ctx, d.Get("user_id").(string), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceUserProfileRead(ctx, d, meta)
+}
+
+func resourceUserProfileDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/squadcast/terraform-provider-squadcast/internal/testdata"
+)
+
+func TestAccResourceUserProfile(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resourceName := "squadcast_user_profile.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceUserProfileConfig(user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "phone", "+14155552671"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr(resourceName, "title", ""),
+					resource.TestCheckResourceAttr(resourceName, "bio", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_phone_verified", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_email_verified"),
+				),
+			},
+			{
+				Config: testAccResourceUserProfileConfig_update(user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "squadcast_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "phone", "+919876543210"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "Asia/Kolkata"),
+					resource.TestCheckResourceAttr(resourceName, "title", "SRE"),
+					resource.TestCheckResourceAttr(resourceName, "bio", "some bio here."),
+					resource.TestCheckResourceAttr(resourceName, "is_phone_verified", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     user.Email,
+			},
+		},
+	})
+}
+
+func TestAccResourceUserProfileInvalidPhone(t *testing.T) {
+	user := testdata.RandomUser()
+
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceUserProfileConfig_invalid_phone(user),
+				ExpectError: regexp.MustCompile("must be a phone number in E.164 format"),
+			},
+		},
+	})
+}
+
+func testAccResourceUserProfileConfig(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_profile" "test" {
+	user_id = squadcast_user.test.id
+	phone = "+14155552671"
+	time_zone = "America/Los_Angeles"
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
+
+func testAccResourceUserProfileConfig_update(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_profile" "test" {
+	user_id = squadcast_user.test.id
+	phone = "+919876543210"
+	time_zone = "Asia/Kolkata"
+	title = "SRE"
+	bio = "some bio here."
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
+
+func testAccResourceUserProfileConfig_invalid_phone(user testdata.User) string {
+	return fmt.Sprintf(`
+resource "squadcast_user" "test" {
+	first_name = "%s"
+	last_name = "%s"
+	email = "%s"
+	role = "user"
+}
+
+resource "squadcast_user_profile" "test" {
+	user_id = squadcast_user.test.id
+	phone = "9876543210"
+}
+	`, user.FirstName, user.LastName, user.Email)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tf"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		Description: "[Outgoing Webhooks](https://support.squadcast.com/docs/webhooks) let you notify external systems, such as ticketing tools or chat bots, whenever an incident goes through a lifecycle event.",
+
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWebhookImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "Webhook id.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description:  "Name of the Webhook.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"description": {
+				Description:  "Detailed description about the Webhook.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			"team_id": {
+				Description:  "Team id.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tf.ValidateObjectID,
+				ForceNew:     true,
+			},
+			"url": {
+				Description:  "The URL to which the webhook payload is sent.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"headers": {
+				Description: "Custom headers sent along with the webhook request.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description:  "Header name.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Description: "Header value.",
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"triggers": {
+				Description: "Incident events which trigger the webhook. " +
+					"Supported values are \"triggered\", \"acknowledged\", \"resolved\", \"reassigned\", \"priority_changed\", \"tags_updated\" and \"note_added\"",
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(api.WebhookTriggers, false),
+				},
+			},
+			"service_ids": {
+				Description: "Only send the webhook for incidents of these services. Leave empty to send it for every service of the team.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: tf.ValidateObjectID,
+				},
+			},
+			"tag_filters": {
+				Description: "Only send the webhook for incidents having all of these tags.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "Tag key.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"value": {
+							Description: "Tag value.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"payload_template": {
+				Description: "Custom payload template, leave empty to send the default incident payload.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	teamID, id, err := parse2PartImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("team_id", teamID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func decodeWebhook(d *schema.ResourceData) (*api.CreateUpdateWebhookReq, error) {
+	var headers []*api.WebhookHeader
+	err := Decode(d.Get("headers"), &headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*api.WebhookTagFilter
+	err = Decode(d.Get("tag_filters"), &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &api.CreateUpdateWebhookReq{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TeamID:      d.Get("team_id").(string),
+		URL:         d.Get("url").(string),
+		Headers:     headers,
+		Triggers:    tf.ListToSlice[string](d.Get("triggers")),
+		Filters: api.WebhookFilters{
+			ServiceIDs: tf.ListToSlice[string](d.Get("service_ids")),
+			Tags:       tags,
+		},
+		PayloadTemplate: d.Get("payload_template").(string),
+	}
+
+	return req, nil
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Creating webhook", tf.M{
+		"name": d.Get("name").(string),
+	})
+	webhook, err := client.CreateWebhook(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(webhook.ID)
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	teamID, ok := d.GetOk("team_id")
+	if !ok {
+		return diag.Errorf("invalid team id provided")
+	}
+
+	tflog.Info(ctx, "Reading webhook", tf.M{
+		"id":   d.Id(),
+		"name": d.Get("name").(string),
+	})
+	webhook, err := client.GetWebhookById(ctx, teamID.(string), id)
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = tf.EncodeAndSet(webhook, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	req, err := decodeWebhook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateWebhook(ctx, d.Id(), req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	_, err := client.DeleteWebhook(ctx, d.Id())
+	if err != nil {
+		if api.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
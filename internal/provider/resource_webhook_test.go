@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/squadcast/terraform-provider-squadcast/internal/api"
+)
+
+func TestAccResourceWebhook(t *testing.T) {
+	webhookName := acctest.RandomWithPrefix("webhook")
+
+	resourceName := "squadcast_webhook.test"
+	resource.UnitTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWebhookConfig(webhookName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", webhookName),
+					resource.TestCheckResourceAttr(resourceName, "url", "https://example.com/hooks/squadcast"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.0", "triggered"),
+					resource.TestCheckResourceAttr(resourceName, "headers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tag_filters.#", "0"),
+				),
+			},
+			{
+				Config: testAccResourceWebhookConfig_update(webhookName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", "613611c1eb22db455cfa789f"),
+					resource.TestCheckResourceAttr(resourceName, "name", webhookName),
+					resource.TestCheckResourceAttr(resourceName, "description", "some description here."),
+					resource.TestCheckResourceAttr(resourceName, "triggers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.0", "triggered"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.1", "resolved"),
+					resource.TestCheckResourceAttr(resourceName, "headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.key", "X-Token"),
+					resource.TestCheckResourceAttr(resourceName, "headers.0.value", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "tag_filters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tag_filters.0.key", "severity"),
+					resource.TestCheckResourceAttr(resourceName, "tag_filters.0.value", "critical"),
+				),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: "613611c1eb22db455cfa789f:",
+			},
+		},
+	})
+}
+
+func testAccCheckWebhookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "squadcast_webhook" {
+			continue
+		}
+
+		_, err := client.GetWebhookById(context.Background(), rs.Primary.Attributes["team_id"], rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected webhook to be destroyed, %s found", rs.Primary.ID)
+		}
+
+		if !api.IsResourceNotFoundError(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceWebhookConfig(webhookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_webhook" "test" {
+	name = "%s"
+	team_id = "613611c1eb22db455cfa789f"
+	url = "https://example.com/hooks/squadcast"
+	triggers = ["triggered"]
+}
+	`, webhookName)
+}
+
+func testAccResourceWebhookConfig_update(webhookName string) string {
+	return fmt.Sprintf(`
+resource "squadcast_webhook" "test" {
+	name = "%s"
+	description = "some description here."
+	team_id = "613611c1eb22db455cfa789f"
+	url = "https://example.com/hooks/squadcast"
+	triggers = ["triggered", "resolved"]
+
+	headers {
+		key = "X-Token"
+		value = "secret"
+	}
+
+	tag_filters {
+		key = "severity"
+		value = "critical"
+	}
+}
+	`, webhookName)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+	"github.com/squadcast/terraform-provider-squadcast/internal/tags"
+)
+
+// validateExpression checks the syntax of a rule expression which may only reference the given roots, e.g. `payload`.
+func validateExpression(roots ...string) schema.SchemaValidateDiagFunc {
+	return func(i any, path cty.Path) diag.Diagnostics {
+		v, ok := i.(string)
+		if !ok {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid expression",
+				Detail:        "Expected type to be string.",
+				AttributePath: path,
+			}}
+		}
+
+		if err := expression.Validate(v, roots...); err != nil {
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Invalid expression",
+				Detail:        fmt.Sprintf("%s, in expression %q.", err, v),
+				AttributePath: path,
+			}}
+		}
+
+		return nil
+	}
+}
+
+// validateTagTemplate checks the syntax of a tag value which may interpolate fields of the payload, e.g. `{{ payload.labels.instance }}`.
+func validateTagTemplate(i any, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid tag value",
+			Detail:        "Expected type to be string.",
+			AttributePath: path,
+		}}
+	}
+
+	if _, err := tags.ParseTemplate(v); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid tag value",
+			Detail:        fmt.Sprintf("%s, in tag value %q.", err, v),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// validateBasicExpressions checks, using the configuration, that the basic expressions of a basic rule compile into an expression,
+// e.g. that their lhs is the path of a field and their op is known. Rules whose expressions are not known yet are not checked.
+func validateBasicExpressions(rule cty.Value, prefix string) error {
+	isBasic, ok := ctyBool(rule.GetAttr("is_basic"))
+	if !ok || !isBasic {
+		return nil
+	}
+
+	conditions := rule.GetAttr("basic_expressions")
+	if !conditions.IsWhollyKnown() || conditions.IsNull() {
+		return nil
+	}
+
+	econditions := make([]expression.Condition, 0, conditions.LengthInt())
+	for _, c := range conditions.AsValueSlice() {
+		var ec expression.Condition
+		for attr, v := range map[string]*string{"lhs": &ec.LHS, "op": &ec.Op, "rhs": &ec.RHS} {
+			// The conditions of routing rules do not have an op.
+			if !c.Type().HasAttribute(attr) || c.GetAttr(attr).IsNull() {
+				continue
+			}
+			*v = c.GetAttr(attr).AsString()
+		}
+		econditions = append(econditions, ec)
+	}
+
+	if _, err := expression.Compile(econditions); err != nil {
+		return fmt.Errorf("%sbasic_expressions: %s", prefix, err)
+	}
+	return nil
+}
+
+// validateRulesBasicExpressions checks the basic expressions of every rule of a resource which manages the rules of a service, see validateBasicExpressions.
+func validateRulesBasicExpressions(d *schema.ResourceDiff) error {
+	rules := d.GetRawConfig().GetAttr("rules")
+	if !rules.IsKnown() || rules.IsNull() {
+		return nil
+	}
+
+	for i, rule := range rules.AsValueSlice() {
+		if err := validateBasicExpressions(rule, fmt.Sprintf("rules.%d: ", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
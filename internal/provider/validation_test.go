@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateBasicExpressions(t *testing.T) {
+	condition := func(lhs, op string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"lhs": cty.StringVal(lhs),
+			"op":  cty.StringVal(op),
+			"rhs": cty.StringVal("bar"),
+		})
+	}
+	rule := func(isBasic bool, conditions ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"is_basic":          cty.BoolVal(isBasic),
+			"basic_expressions": cty.ListVal(conditions),
+		})
+	}
+
+	cases := []struct {
+		name string
+		rule cty.Value
+		want string
+	}{
+		{name: "valid", rule: rule(true, condition(`payload["foo"]`, "is"))},
+		{name: "advanced rule", rule: rule(false, condition(`payload["foo"]`, "unknown"))},
+		{name: "unknown op", rule: rule(true, condition(`payload["foo"]`, "is"), condition(`payload["bar"]`, "unknown")), want: "rules.0: basic_expressions: condition 1: unknown op"},
+		{name: "lhs not a path", rule: rule(true, condition(`1 + 1`, "is")), want: "rules.0: basic_expressions: condition 0: lhs must be the path of a field"},
+		{name: "unknown lhs", rule: rule(true, cty.ObjectVal(map[string]cty.Value{
+			"lhs": cty.UnknownVal(cty.String),
+			"op":  cty.StringVal("unknown"),
+			"rhs": cty.StringVal("bar"),
+		}))},
+		{name: "without op", rule: rule(true, cty.ObjectVal(map[string]cty.Value{
+			"lhs": cty.StringVal(`payload["foo"]`),
+			"rhs": cty.StringVal("bar"),
+		}))},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateBasicExpressions(c.rule, "rules.0: ")
+			switch {
+			case c.want == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case c.want != "" && (err == nil || !strings.HasPrefix(err.Error(), c.want)):
+				t.Fatalf("expected an error starting with %q, got %v", c.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Package tags validates the tags added by tagging rules against a registry of the tags of an organization,
+// so every team uses the same keys, values and colors.
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Tag is a registered tag. A tag without values or colors accepts any value or color.
+type Tag struct {
+	Key    string
+	Values []string
+	Colors []string
+}
+
+// Registry is the registry of the tags of an organization.
+type Registry struct {
+	Tags []*Tag
+	// Strict is true when tagging rules may only add registered tags.
+	Strict bool
+}
+
+// NewRegistry returns the registry of the given tags, or an error if a key is registered twice, regardless of case.
+func NewRegistry(tags []*Tag, strict bool) (*Registry, error) {
+	keys := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		if key, ok := keys[strings.ToLower(tag.Key)]; ok {
+			return nil, fmt.Errorf("tag %q is registered more than once, as %q and %q", tag.Key, key, tag.Key)
+		}
+		keys[strings.ToLower(tag.Key)] = tag.Key
+	}
+	return &Registry{Tags: tags, Strict: strict}, nil
+}
+
+// Keys returns the registered keys, sorted.
+func (r *Registry) Keys() []string {
+	keys := make([]string, len(r.Tags))
+	for i, tag := range r.Tags {
+		keys[i] = tag.Key
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// lookup returns the tag whose key is the given key, or whose key only differs by case, if any.
+func (r *Registry) lookup(key string) *Tag {
+	var folded *Tag
+	for _, tag := range r.Tags {
+		if tag.Key == key {
+			return tag
+		}
+		if strings.EqualFold(tag.Key, key) {
+			folded = tag
+		}
+	}
+	return folded
+}
+
+// Validate checks a tag added by a tagging rule. Keys and values which only differ by case from registered ones are always rejected,
+// keys which are not registered are only rejected in strict mode. Values which interpolate fields of the payload are not validated,
+// except in strict mode where they are rejected for tags with allowed values, since they could render any value.
+// Colors are compared regardless of case.
+// A nil registry accepts any tag.
+func (r *Registry) Validate(key, value, color string) error {
+	if r == nil {
+		return nil
+	}
+
+	tag := r.lookup(key)
+	if tag == nil {
+		if r.Strict {
+			return fmt.Errorf("tag %q is not registered, expected one of %s", key, list(r.Keys()))
+		}
+		return nil
+	}
+	if tag.Key != key {
+		return fmt.Errorf("tag %q is not registered, did you mean %q?", key, tag.Key)
+	}
+
+	if len(tag.Values) > 0 {
+		// Syntax errors of templates are reported by the validation of the value.
+		if t, err := ParseTemplate(value); err == nil && t.IsStatic() {
+			if err := oneOf(fmt.Sprintf("value of tag %q", key), value, tag.Values, false); err != nil {
+				return err
+			}
+		} else if err == nil && r.Strict {
+			return fmt.Errorf("value of tag %q cannot interpolate fields of the payload in strict mode, expected one of %s", key, list(tag.Values))
+		}
+	}
+	if len(tag.Colors) > 0 {
+		if err := oneOf(fmt.Sprintf("color of tag %q", key), color, tag.Colors, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func oneOf(name, v string, allowed []string, fold bool) error {
+	for _, a := range allowed {
+		if a == v || (fold && strings.EqualFold(a, v)) {
+			return nil
+		}
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(a, v) {
+			return fmt.Errorf("%s %q is not allowed, did you mean %q?", name, v, a)
+		}
+	}
+	return fmt.Errorf("%s %q is not allowed, expected one of %s", name, v, list(allowed))
+}
+
+func list(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
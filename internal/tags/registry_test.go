@@ -0,0 +1,68 @@
+package tags
+
+import (
+	"testing"
+)
+
+func TestRegistryValidate(t *testing.T) {
+	tags := []*Tag{
+		{Key: "severity", Values: []string{"critical", "high", "low"}, Colors: []string{"#FF0000", "#ffa500", "#00ff00"}},
+		{Key: "team"},
+	}
+
+	cases := []struct {
+		name   string
+		strict bool
+		key    string
+		value  string
+		color  string
+		want   string
+	}{
+		{name: "registered", key: "severity", value: "high", color: "#FFA500"},
+		{name: "any value", key: "team", value: "db", color: "#123456"},
+		{name: "unregistered", key: "env", value: "prod", color: "#123456"},
+		{name: "unregistered in strict mode", strict: true, key: "env", value: "prod", color: "#123456", want: `tag "env" is not registered, expected one of "severity", "team"`},
+		{name: "key case", key: "Severity", value: "high", color: "#ff0000", want: `tag "Severity" is not registered, did you mean "severity"?`},
+		{name: "value case", key: "severity", value: "High", color: "#ff0000", want: `value of tag "severity" "High" is not allowed, did you mean "high"?`},
+		{name: "value", key: "severity", value: "medium", color: "#ff0000", want: `value of tag "severity" "medium" is not allowed, expected one of "critical", "high", "low"`},
+		{name: "templated value", key: "severity", value: "{{ payload.severity }}", color: "#ff0000"},
+		{name: "templated value in strict mode", strict: true, key: "severity", value: "{{ payload.severity }}", color: "#ff0000", want: `value of tag "severity" cannot interpolate fields of the payload in strict mode, expected one of "critical", "high", "low"`},
+		{name: "templated value of any value in strict mode", strict: true, key: "team", value: "{{ payload.team }}", color: "#123456"},
+		{name: "templated value color", key: "severity", value: "{{ payload.severity }}", color: "#0000ff", want: `color of tag "severity" "#0000ff" is not allowed, expected one of "#FF0000", "#ffa500", "#00ff00"`},
+		{name: "color", key: "severity", value: "low", color: "#0000ff", want: `color of tag "severity" "#0000ff" is not allowed, expected one of "#FF0000", "#ffa500", "#00ff00"`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r, err := NewRegistry(tags, c.strict)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = r.Validate(c.key, c.value, c.color)
+			if c.want == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != c.want {
+				t.Fatalf("expected %q, got %v", c.want, err)
+			}
+		})
+	}
+}
+
+func TestNewRegistryDuplicateKeys(t *testing.T) {
+	_, err := NewRegistry([]*Tag{{Key: "severity"}, {Key: "Severity"}}, false)
+	if want := `tag "Severity" is registered more than once, as "severity" and "Severity"`; err == nil || err.Error() != want {
+		t.Fatalf("expected %q, got %v", want, err)
+	}
+}
+
+func TestNilRegistry(t *testing.T) {
+	var r *Registry
+	if err := r.Validate("any", "value", "#000"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
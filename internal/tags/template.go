@@ -0,0 +1,142 @@
+package tags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/squadcast/terraform-provider-squadcast/internal/expression"
+)
+
+// Template is a tag value which may interpolate fields of the alert payload, e.g. `{{ payload.labels.instance }}`,
+// with a default value when the field does not exist, e.g. `{{ payload.labels.instance | default "unknown" }}`.
+type Template struct {
+	parts []templatePart
+}
+
+// templatePart is either a static text, or a path with its default value.
+type templatePart struct {
+	text  string
+	path  expression.Node
+	deflt string
+}
+
+// ParseTemplate parses a tag value. Values without `{{` are static. The returned error is a *expression.SyntaxError.
+func ParseTemplate(src string) (*Template, error) {
+	t := &Template{}
+	offset := 0
+	for {
+		start := strings.Index(src[offset:], "{{")
+		if start < 0 {
+			if offset < len(src) {
+				t.parts = append(t.parts, templatePart{text: src[offset:]})
+			}
+			return t, nil
+		}
+		start += offset
+		if start > offset {
+			t.parts = append(t.parts, templatePart{text: src[offset:start]})
+		}
+
+		end := strings.Index(src[start:], "}}")
+		if end < 0 {
+			return nil, &expression.SyntaxError{Column: start + 1, Message: "unclosed `{{`, expected `}}`"}
+		}
+		end += start
+
+		part, err := parseInterpolation(src[start+2:end], start+3)
+		if err != nil {
+			return nil, err
+		}
+		t.parts = append(t.parts, part)
+		offset = end + 2
+	}
+}
+
+// parseInterpolation parses the content of `{{ }}`, starting at the given column.
+func parseInterpolation(src string, column int) (templatePart, error) {
+	path, deflt := src, ""
+	if i := pipe(src); i >= 0 {
+		path = src[:i]
+
+		filter := strings.TrimSpace(src[i+1:])
+		arg := strings.TrimPrefix(filter, "default")
+		node, err := expression.Parse(arg)
+		literal, ok := node.(*expression.Literal)
+		if arg == filter || err != nil || !ok {
+			return templatePart{}, &expression.SyntaxError{Column: column + i, Message: fmt.Sprintf("unexpected %q, expected `default` followed by a string", filter)}
+		}
+		if s, ok := literal.Value.(string); ok {
+			deflt = s
+		} else {
+			deflt = expression.ToString(literal.Value)
+		}
+	}
+
+	if strings.TrimSpace(path) == "" {
+		return templatePart{}, &expression.SyntaxError{Column: column, Message: "empty interpolation, expected a field of the payload, e.g. `payload.labels.instance`"}
+	}
+	if err := expression.Validate(path, expression.PayloadRoots...); err != nil {
+		if serr, ok := err.(*expression.SyntaxError); ok {
+			return templatePart{}, &expression.SyntaxError{Column: column + serr.Column - 1, Message: serr.Message}
+		}
+		return templatePart{}, err
+	}
+	node, _ := expression.Parse(path)
+	if !expression.IsPath(node) {
+		return templatePart{}, &expression.SyntaxError{Column: column + strings.Index(path, strings.TrimSpace(path)), Message: fmt.Sprintf("%s is not a field of the payload, e.g. `payload.labels.instance`", node)}
+	}
+
+	return templatePart{path: node, deflt: deflt}, nil
+}
+
+// pipe returns the index of the first `|` which is not in a string, or -1.
+func pipe(src string) int {
+	var quote byte
+	for i := 0; i < len(src); i++ {
+		switch c := src[i]; {
+		case quote != 0 && c == '\\':
+			i++
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == 0 && c == '|' && (i+1 == len(src) || src[i+1] != '|'):
+			return i
+		case quote == 0 && c == '|':
+			i++
+		}
+	}
+	return -1
+}
+
+// IsStatic returns true if the template does not interpolate any field.
+func (t *Template) IsStatic() bool {
+	for _, part := range t.parts {
+		if part.path != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Render returns the value of the tag for the payload. Fields which do not exist, or are null, are replaced by their default value.
+func (t *Template) Render(payload any) (string, error) {
+	var b strings.Builder
+	for _, part := range t.parts {
+		if part.path == nil {
+			b.WriteString(part.text)
+			continue
+		}
+
+		v, err := expression.Eval(part.path, expression.Env{"payload": payload})
+		if err != nil {
+			return "", err
+		}
+		if v == nil {
+			b.WriteString(part.deflt)
+		} else {
+			b.WriteString(expression.ToString(v))
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,70 @@
+package tags
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTemplateRender(t *testing.T) {
+	var payload any
+	if err := json.Unmarshal([]byte(`{"labels": {"instance": "db-1:9100", "cluster": null}, "count": 3, "hosts": ["a", "b"]}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		src    string
+		want   string
+		static bool
+	}{
+		{src: "database", want: "database", static: true},
+		{src: "", want: "", static: true},
+		{src: "{{payload.labels.instance}}", want: "db-1:9100"},
+		{src: "host-{{ payload.labels[\"instance\"] }}-{{ payload.count }}", want: "host-db-1:9100-3"},
+		{src: "{{ payload.labels.cluster | default \"unknown\" }}", want: "unknown"},
+		{src: "{{ payload.labels.missing | default 'a|b' }}", want: "a|b"},
+		{src: "{{ payload.labels.missing }}", want: ""},
+		{src: "{{ payload.hosts[1] }}", want: "b"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			tmpl, err := ParseTemplate(c.src)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tmpl.IsStatic() != c.static {
+				t.Fatalf("expected static to be %v", c.static)
+			}
+			got, err := tmpl.Render(payload)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != c.want {
+				t.Fatalf("expected %q, got %q", c.want, got)
+			}
+		})
+	}
+}
+
+func TestParseTemplateErrors(t *testing.T) {
+	cases := []struct {
+		src  string
+		want string
+	}{
+		{src: "host-{{ payload.host", want: "column 6: unclosed `{{`, expected `}}`"},
+		{src: "{{ }}", want: "column 3: empty interpolation, expected a field of the payload, e.g. `payload.labels.instance`"},
+		{src: "{{ labels.instance }}", want: "column 4: unknown identifier `labels`, expected one of `payload`"},
+		{src: "{{ payload.a == 1 }}", want: "column 4: payload.a == 1 is not a field of the payload, e.g. `payload.labels.instance`"},
+		{src: "{{ payload.a | upper }}", want: "column 14: unexpected \"upper\", expected `default` followed by a string"},
+		{src: "{{ payload.a | default }}", want: "column 14: unexpected \"default\", expected `default` followed by a string"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.src, func(t *testing.T) {
+			_, err := ParseTemplate(c.src)
+			if err == nil || err.Error() != c.want {
+				t.Fatalf("expected %q, got %v", c.want, err)
+			}
+		})
+	}
+}
@@ -1,5 +1,49 @@
 package tf
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+import (
+	"fmt"
+	"regexp"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 var ValidateObjectID = validation.StringLenBetween(24, 24)
+
+var ValidatePhoneNumber = validation.StringMatch(regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`), "must be a phone number in E.164 format, e.g. +14155552671")
+
+var ValidateHexColor = validation.StringMatch(regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`), "must be a hex color, e.g. #0f61dd")
+
+// ValidateTimeZone checks that the value is a valid IANA time zone name, e.g. "Asia/Kolkata".
+func ValidateTimeZone(i any, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	if v == "" || v == "Local" {
+		return nil, []error{fmt.Errorf("expected %s to be a valid IANA time zone, got %q", k, v)}
+	}
+
+	if _, err := time.LoadLocation(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid IANA time zone, got %q", k, v)}
+	}
+
+	return nil, nil
+}
+
+// AllDiag runs the validators in order and returns the diagnostics of the first one which fails.
+func AllDiag(validators ...schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(i any, path cty.Path) diag.Diagnostics {
+		for _, validator := range validators {
+			if diags := validator(i, path); diags.HasError() {
+				return diags
+			}
+		}
+		return nil
+	}
+}